@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	databaseUtils "github.com/KnightHacks/knighthacks_shared/database"
+	"github.com/KnightHacks/knighthacks_shared/utils"
+	"github.com/KnightHacks/knighthacks_users/repository/database"
+	"log"
+	"os"
+	"sort"
+)
+
+// backfill_phone_numbers rewrites the phone numbers stored before they were normalized to
+// E.164, it only needs to be run once against each database.
+//
+// usage: DATABASE_URI=... go run ./cmd/backfill_phone_numbers [-dry-run]
+func main() {
+	dryRun := flag.Bool("dry-run", false, "report what would change without changing anything")
+	flag.Parse()
+
+	pool, err := databaseUtils.ConnectWithRetries(utils.GetEnvOrDie("DATABASE_URI"))
+	if err != nil {
+		log.Fatalf("Unable to connect to database: %v\n", err)
+	}
+	defer pool.Close()
+
+	databaseRepository, err := database.NewDatabaseRepository(context.Background(), pool)
+	if err != nil {
+		log.Fatalf("error occured while initializing database repository err = %v\n", err)
+	}
+
+	report, err := databaseRepository.BackfillPhoneNumbers(context.Background(), *dryRun)
+	if err != nil {
+		log.Fatalf("unable to backfill phone numbers err = %v\n", err)
+	}
+
+	if *dryRun {
+		fmt.Println("dry run, nothing has been changed")
+	}
+	fmt.Printf("updated: %d, already normalized: %d, invalid: %d, collisions: %d\n",
+		report.Updated, report.Unchanged, len(report.Invalid), len(report.Collisions))
+
+	invalidIds := make([]string, 0, len(report.Invalid))
+	for userId := range report.Invalid {
+		invalidIds = append(invalidIds, userId)
+	}
+	sort.Strings(invalidIds)
+	for _, userId := range invalidIds {
+		fmt.Printf("invalid: user %s has %q\n", userId, report.Invalid[userId])
+	}
+	for _, collision := range report.Collisions {
+		fmt.Printf("collision: %s is used by users %v\n", collision.PhoneNumber, collision.UserIDs)
+	}
+	if len(report.Invalid) > 0 || len(report.Collisions) > 0 {
+		os.Exit(1)
+	}
+}
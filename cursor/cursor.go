@@ -0,0 +1,40 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var NotValid = errors.New("cursor is not valid")
+
+// Cursor is the position of an entry in a sorted list, the id breaks ties between entries
+// with the same sort key. Cursors are opaque to clients.
+type Cursor struct {
+	// Sort is the name of the order the list was sorted in, a cursor can only be used with the
+	// sort it was made for
+	Sort string `json:"s,omitempty"`
+	// Key is the value of the entry's sort key, empty when the list is sorted by id
+	Key string `json:"k,omitempty"`
+	ID  string `json:"i"`
+}
+
+func (c Cursor) Encode() string {
+	// marshalling a struct of strings can't fail
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Decode returns the cursor encoded by Encode, NotValid is returned if the cursor wasn't made
+// by Encode
+func Decode(encoded string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, NotValid
+	}
+	var c Cursor
+	if err = json.Unmarshal(b, &c); err != nil || c.ID == "" {
+		return nil, NotValid
+	}
+	return &c, nil
+}
@@ -0,0 +1,32 @@
+package cursor
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCursor(t *testing.T) {
+	tests := []Cursor{
+		{ID: "1"},
+		{Sort: "LAST_NAME", Key: "O'Brien, \"Bob\"", ID: "42"},
+		{Sort: "CREATED", Key: "2023-01-02T03:04:05Z", ID: "7"},
+	}
+	for _, want := range tests {
+		got, err := Decode(want.Encode())
+		if err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		if !reflect.DeepEqual(*got, want) {
+			t.Errorf("Decode() got = %v, want %v", *got, want)
+		}
+	}
+}
+
+func TestDecode_NotValid(t *testing.T) {
+	for _, encoded := range []string{"", "not base64!", "bm90IGpzb24", Cursor{Sort: "ID"}.Encode()} {
+		if _, err := Decode(encoded); !errors.Is(err, NotValid) {
+			t.Errorf("Decode(%q) error = %v, want %v", encoded, err, NotValid)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// FetchFunc loads the values of every key in one go, keys that don't have a value are left out
+// of the returned map
+type FetchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Loader collects the keys loaded within wait of the first one and fetches them with a single
+// call to fetch. Values are not cached past the batch they were fetched in, so a loader should
+// be created for each request.
+type Loader[K comparable, V any] struct {
+	fetch    FetchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+	timeout  time.Duration
+
+	mu    sync.Mutex
+	batch *batch[K, V]
+}
+
+type batch[K comparable, V any] struct {
+	keys []K
+	seen map[K]struct{}
+	once sync.Once
+	// done is closed once results and err have been set
+	done    chan struct{}
+	results map[K]V
+	err     error
+}
+
+// New creates a loader, a batch is fetched once wait has passed or it has maxBatch keys and the
+// fetch is given at most timeout
+func New[K comparable, V any](fetch FetchFunc[K, V], wait time.Duration, maxBatch int, timeout time.Duration) *Loader[K, V] {
+	return &Loader[K, V]{fetch: fetch, wait: wait, maxBatch: maxBatch, timeout: timeout}
+}
+
+// Load returns the value of the key, the zero value is returned if the key has no value
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	b := l.batch
+	if b == nil {
+		b = &batch[K, V]{seen: map[K]struct{}{}, done: make(chan struct{})}
+		l.batch = b
+		time.AfterFunc(l.wait, func() { l.dispatch(ctx, b) })
+	}
+	if _, ok := b.seen[key]; !ok {
+		b.seen[key] = struct{}{}
+		b.keys = append(b.keys, key)
+	}
+	full := len(b.keys) >= l.maxBatch
+	l.mu.Unlock()
+	if full {
+		go l.dispatch(ctx, b)
+	}
+
+	select {
+	case <-b.done:
+		if b.err != nil {
+			var zero V
+			return zero, b.err
+		}
+		return b.results[key], nil
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// dispatch fetches the batch, it is called both when the batch is full and when the wait is
+// over so only the first call does anything. The batch is shared by every caller that loaded
+// a key into it, so it isn't cancelled with the ctx of the caller that started it. Each caller
+// stops waiting once their own ctx is done.
+func (l *Loader[K, V]) dispatch(ctx context.Context, b *batch[K, V]) {
+	b.once.Do(func() {
+		// no more keys can be added to the batch once it is being fetched
+		l.mu.Lock()
+		if l.batch == b {
+			l.batch = nil
+		}
+		l.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), l.timeout)
+		defer cancel()
+		b.results, b.err = l.fetch(ctx, b.keys)
+		close(b.done)
+	})
+}
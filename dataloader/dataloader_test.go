@@ -0,0 +1,174 @@
+package dataloader
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recorder struct {
+	mu      sync.Mutex
+	batches [][]int
+}
+
+func (r *recorder) fetch(ctx context.Context, keys []int) (map[int]string, error) {
+	r.mu.Lock()
+	r.batches = append(r.batches, append([]int(nil), keys...))
+	r.mu.Unlock()
+	values := make(map[int]string, len(keys))
+	for _, key := range keys {
+		// odd keys have no value
+		if key%2 == 0 {
+			values[key] = strconv.Itoa(key)
+		}
+	}
+	return values, nil
+}
+
+func loadAll(t *testing.T, loader *Loader[int, string], keys []int) []string {
+	t.Helper()
+	values := make([]string, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func(i int, key int) {
+			defer wg.Done()
+			value, err := loader.Load(context.Background(), key)
+			if err != nil {
+				t.Errorf("Load() error = %v", err)
+			}
+			values[i] = value
+		}(i, key)
+	}
+	wg.Wait()
+	return values
+}
+
+func TestLoader_Load(t *testing.T) {
+	var r recorder
+	loader := New[int, string](r.fetch, 10*time.Millisecond, 100, time.Second)
+
+	keys := []int{2, 4, 2, 3, 6, 4}
+	values := loadAll(t, loader, keys)
+	for i, key := range keys {
+		want := ""
+		if key%2 == 0 {
+			want = strconv.Itoa(key)
+		}
+		if values[i] != want {
+			t.Errorf("Load(%d) = %v, want %v", key, values[i], want)
+		}
+	}
+
+	if len(r.batches) != 1 {
+		t.Fatalf("fetch was called %d times, want once", len(r.batches))
+	}
+	got := r.batches[0]
+	sort.Ints(got)
+	if want := []int{2, 3, 4, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("fetch keys = %v, want %v", got, want)
+	}
+
+	// values aren't cached so a later load fetches again
+	loadAll(t, loader, []int{2})
+	if len(r.batches) != 2 {
+		t.Errorf("fetch was called %d times, want twice", len(r.batches))
+	}
+}
+
+func TestLoader_MaxBatch(t *testing.T) {
+	var r recorder
+	// the wait is long enough that only a full batch would be fetched during the test
+	loader := New[int, string](r.fetch, time.Hour, 2, time.Second)
+
+	done := make(chan struct{})
+	go func() {
+		loadAll(t, loader, []int{1, 2})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("full batch was not fetched")
+	}
+	if len(r.batches) != 1 || len(r.batches[0]) != 2 {
+		t.Errorf("fetch batches = %v, want a single batch of 2", r.batches)
+	}
+}
+
+func TestLoader_Error(t *testing.T) {
+	fetchErr := errors.New("fetch failed")
+	loader := New[int, string](func(ctx context.Context, keys []int) (map[int]string, error) {
+		return nil, fetchErr
+	}, time.Millisecond, 100, time.Second)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func(key int) {
+			defer wg.Done()
+			if _, err := loader.Load(context.Background(), key); !errors.Is(err, fetchErr) {
+				t.Errorf("Load() error = %v, want %v", err, fetchErr)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestLoader_ContextCanceled(t *testing.T) {
+	loader := New[int, string](func(ctx context.Context, keys []int) (map[int]string, error) {
+		return nil, nil
+	}, time.Hour, 100, time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := loader.Load(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("Load() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestLoader_FirstCallerCanceled(t *testing.T) {
+	loader := New[int, string](func(ctx context.Context, keys []int) (map[int]string, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return map[int]string{1: "1", 2: "2"}, nil
+	}, 10*time.Millisecond, 100, time.Second)
+
+	// the first caller starts the batch and gives up before it is fetched
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, err := loader.Load(ctx, 1)
+		first <- err
+	}()
+	time.Sleep(time.Millisecond)
+	cancel()
+
+	value, err := loader.Load(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if value != "2" {
+		t.Errorf("Load() = %v, want %v", value, "2")
+	}
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Errorf("first Load() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestLoader_Timeout(t *testing.T) {
+	loader := New[int, string](func(ctx context.Context, keys []int) (map[int]string, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}, time.Millisecond, 100, 10*time.Millisecond)
+
+	if _, err := loader.Load(context.Background(), 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Load() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,111 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+var InvalidHeader = errors.New("email headers cannot contain line breaks")
+
+// Message is a plain text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender sends emails, SMTPSender is used in production and LogSender everywhere else
+type Sender interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// SMTPSender sends emails through an SMTP server
+type SMTPSender struct {
+	// Addr is the host:port of the SMTP server
+	Addr string
+	Auth smtp.Auth
+	From string
+}
+
+func (s *SMTPSender) Send(ctx context.Context, message Message) error {
+	body, err := format(s.From, message)
+	if err != nil {
+		return err
+	}
+	return smtp.SendMail(s.Addr, s.Auth, s.From, []string{message.To}, body)
+}
+
+// LogSender writes every email to Out instead of sending it, it stands in for SMTPSender
+// when developing and testing
+type LogSender struct {
+	mu  sync.Mutex
+	Out io.Writer
+}
+
+func (s *LogSender) Send(ctx context.Context, message Message) error {
+	body, err := format("noreply@localhost", message)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = fmt.Fprintf(s.Out, "%s\n\n", body)
+	return err
+}
+
+// SenderFromEnvironment returns an SMTPSender when SMTP_HOST is set, using SMTP_PORT,
+// SMTP_USERNAME, SMTP_PASSWORD and SMTP_FROM. Otherwise emails are written to the file
+// EMAIL_LOG_FILE, or stdout if that isn't set either.
+func SenderFromEnvironment() (Sender, error) {
+	if host, ok := os.LookupEnv("SMTP_HOST"); ok {
+		port := os.Getenv("SMTP_PORT")
+		if port == "" {
+			port = "587"
+		}
+		from := os.Getenv("SMTP_FROM")
+		if from == "" {
+			return nil, errors.New("SMTP_FROM must be set when SMTP_HOST is set")
+		}
+		var auth smtp.Auth
+		if username := os.Getenv("SMTP_USERNAME"); username != "" {
+			auth = smtp.PlainAuth("", username, os.Getenv("SMTP_PASSWORD"), host)
+		}
+		return &SMTPSender{Addr: host + ":" + port, Auth: auth, From: from}, nil
+	}
+	if path, ok := os.LookupEnv("EMAIL_LOG_FILE"); ok {
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, err
+		}
+		return &LogSender{Out: file}, nil
+	}
+	log.Println("SMTP_HOST is not set, emails will be written to stdout instead of being sent")
+	return &LogSender{Out: os.Stdout}, nil
+}
+
+// format returns the message as it is sent over SMTP
+func format(from string, message Message) ([]byte, error) {
+	for _, header := range []string{from, message.To, message.Subject} {
+		if strings.ContainsAny(header, "\r\n") {
+			return nil, InvalidHeader
+		}
+	}
+	var b strings.Builder
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + message.To + "\r\n")
+	b.WriteString("Subject: " + message.Subject + "\r\n")
+	b.WriteString("Date: " + time.Now().UTC().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+	return []byte(b.String()), nil
+}
@@ -0,0 +1,46 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLogSender_Send(t *testing.T) {
+	var out bytes.Buffer
+	sender := &LogSender{Out: &out}
+	err := sender.Send(context.Background(), Message{
+		To:      "joe.bob@example.com",
+		Subject: "Confirm your new email",
+		Body:    "first line\nsecond line",
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	for _, want := range []string{
+		"To: joe.bob@example.com\r\n",
+		"Subject: Confirm your new email\r\n",
+		"\r\n\r\nfirst line\r\nsecond line",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Send() wrote %q, want it to contain %q", out.String(), want)
+		}
+	}
+}
+
+func TestLogSender_SendHeaderInjection(t *testing.T) {
+	var out bytes.Buffer
+	sender := &LogSender{Out: &out}
+	err := sender.Send(context.Background(), Message{
+		To:      "joe.bob@example.com\r\nBcc: everyone@example.com",
+		Subject: "Confirm your new email",
+	})
+	if !errors.Is(err, InvalidHeader) {
+		t.Errorf("Send() error = %v, want %v", err, InvalidHeader)
+	}
+	if out.Len() != 0 {
+		t.Errorf("Send() wrote %q", out.String())
+	}
+}
@@ -1,13 +1,16 @@
 module github.com/KnightHacks/knighthacks_users
 
-go 1.19
+go 1.21
 
 require (
 	github.com/99designs/gqlgen v0.17.31
 	github.com/KnightHacks/knighthacks_shared v0.0.0-20221123184357-0f1e8db71c48
 	github.com/gin-gonic/gin v1.9.0
 	github.com/jackc/pgx/v5 v5.3.1
+	github.com/nyaruka/phonenumbers v1.1.7
 	github.com/vektah/gqlparser/v2 v2.5.1
+	golang.org/x/oauth2 v0.8.0
+	golang.org/x/text v0.9.0
 )
 
 require (
@@ -49,10 +52,8 @@ require (
 	golang.org/x/crypto v0.9.0 // indirect
 	golang.org/x/mod v0.10.0 // indirect
 	golang.org/x/net v0.10.0 // indirect
-	golang.org/x/oauth2 v0.8.0 // indirect
 	golang.org/x/sync v0.2.0 // indirect
 	golang.org/x/sys v0.8.0 // indirect
-	golang.org/x/text v0.9.0 // indirect
 	golang.org/x/tools v0.9.1 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
 	google.golang.org/protobuf v1.30.0 // indirect
@@ -0,0 +1,42 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/KnightHacks/knighthacks_users/email"
+	"net/url"
+	"time"
+)
+
+// EmailChangeExpiry is how long the link sent to confirm a new email works for
+const EmailChangeExpiry = 24 * time.Hour
+
+// SendEmailChange sends the link to confirm the new email of an email change started by
+// Repository.UpdateUser, the user's email is only changed once the link is followed
+func (r *Resolver) SendEmailChange(ctx context.Context, newEmail string, token string) error {
+	link, err := tokenLink(r.EmailConfirmationURL, token)
+	if err != nil {
+		return err
+	}
+	return r.EmailSender.Send(ctx, email.Message{
+		To:      newEmail,
+		Subject: "Confirm your new Knight Hacks email",
+		Body: fmt.Sprintf("Follow this link to start using this email for your Knight Hacks account:\n\n%s\n\n"+
+			"The link expires in %d hours. If you didn't ask to change your email you can ignore this email.",
+			link,
+			int(EmailChangeExpiry.Hours()),
+		),
+	})
+}
+
+// tokenLink adds the token to the page's query parameters
+func tokenLink(page string, token string) (string, error) {
+	link, err := url.Parse(page)
+	if err != nil {
+		return "", err
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String(), nil
+}
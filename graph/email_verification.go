@@ -0,0 +1,71 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/KnightHacks/knighthacks_shared/auth"
+	"github.com/KnightHacks/knighthacks_users/email"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/oauth"
+	"strings"
+	"time"
+)
+
+// EmailVerificationExpiry is how long the link sent to verify an email works for
+const EmailVerificationExpiry = 48 * time.Hour
+
+// VerifyRegistrationEmail verifies the email of a newly registered user straight away when
+// their OAuth provider has already verified it, otherwise a verification link is sent to it
+func (r *Resolver) VerifyRegistrationEmail(ctx context.Context, user *model.User, profile *oauth.Profile) error {
+	for _, providerEmail := range profile.VerifiedEmails {
+		if !strings.EqualFold(strings.TrimSpace(providerEmail), user.Email) {
+			continue
+		}
+		verified, err := r.Repository.MarkEmailVerified(ctx, user.ID, user.Email)
+		if err != nil {
+			return err
+		}
+		user.EmailVerified = verified
+		return nil
+	}
+	return r.SendEmailVerification(ctx, user.ID)
+}
+
+// SendEmailVerification sends a link that verifies the user's current email to it
+func (r *Resolver) SendEmailVerification(ctx context.Context, userId string) error {
+	token, to, err := r.Repository.CreateEmailVerification(ctx, userId, time.Now().Add(EmailVerificationExpiry))
+	if err != nil {
+		return err
+	}
+	link, err := tokenLink(r.EmailVerificationURL, token)
+	if err != nil {
+		return err
+	}
+	return r.EmailSender.Send(ctx, email.Message{
+		To:      to,
+		Subject: "Verify your Knight Hacks email",
+		Body: fmt.Sprintf("Follow this link to verify the email of your Knight Hacks account:\n\n%s\n\n"+
+			"The link expires in %d hours. If you didn't create a Knight Hacks account you can ignore this email.",
+			link,
+			int(EmailVerificationExpiry.Hours()),
+		),
+	})
+}
+
+// EmailVerifiedDirective implements @emailVerified, the field is only resolved if the logged
+// in user has verified their email
+func (r *Resolver) EmailVerifiedDirective(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return nil, NotAuthenticated
+	}
+	verified, err := r.Repository.IsEmailVerified(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !verified {
+		return nil, EmailNotVerified
+	}
+	return next(ctx)
+}
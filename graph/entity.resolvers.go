@@ -2,11 +2,11 @@ package graph
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.31
 
 import (
 	"context"
 
-	"github.com/KnightHacks/knighthacks_shared/models"
 	"github.com/KnightHacks/knighthacks_users/graph/generated"
 	"github.com/KnightHacks/knighthacks_users/graph/model"
 )
@@ -18,14 +18,12 @@ func (r *entityResolver) FindHackathonApplicationByID(ctx context.Context, id st
 
 // FindUserByID is the resolver for the findUserByID field.
 func (r *entityResolver) FindUserByID(ctx context.Context, id string) (*model.User, error) {
-	user, err := r.Resolver.Repository.GetUserByID(ctx, id)
-	return user, err
+	return r.Resolver.LoadUser(ctx, id)
 }
 
 // FindUserByOAuthUIDAndOAuthProvider is the resolver for the findUserByOAuthUIDAndOAuthProvider field.
-func (r *entityResolver) FindUserByOAuthUIDAndOAuthProvider(ctx context.Context, oAuthUID string, oAuthProvider models.Provider) (*model.User, error) {
-	user, err := r.Resolver.Repository.GetUserByOAuthUID(ctx, oAuthUID, oAuthProvider)
-	return user, err
+func (r *entityResolver) FindUserByOAuthUIDAndOAuthProvider(ctx context.Context, oAuthUID string, oAuthProvider model.OAuthProvider) (*model.User, error) {
+	return r.Resolver.LoadUserByOAuth(ctx, model.OAuth{Provider: oAuthProvider, UID: oAuthUID})
 }
 
 // Entity returns generated.EntityResolver implementation.
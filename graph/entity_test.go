@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/KnightHacks/knighthacks_shared/models"
+	"github.com/KnightHacks/knighthacks_users/graph/generated"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"testing"
+)
+
+// usersRepository only implements GetUsersByIDs, calling anything else panics
+type usersRepository struct {
+	repository.Repository
+	users map[string]*model.User
+}
+
+func (r *usersRepository) GetUsersByIDs(ctx context.Context, ids []string) (map[string]*model.User, error) {
+	users := make(map[string]*model.User, len(ids))
+	for _, id := range ids {
+		if user, ok := r.users[id]; ok {
+			users[id] = user
+		}
+	}
+	return users, nil
+}
+
+// TestFindUserByID_EmailVerified resolves the User entity the way the gateway does for the
+// hackathon service, which isn't logged in as the user
+func TestFindUserByID_EmailVerified(t *testing.T) {
+	resolver := &Resolver{Repository: &usersRepository{users: map[string]*model.User{
+		"1": {ID: "1", Email: "joe.bob@example.com", EmailVerified: true},
+		"2": {ID: "2", Email: "jane.doe@example.com", EmailVerified: false},
+	}}}
+	config := generated.Config{
+		Resolvers: resolver,
+		Directives: generated.DirectiveRoot{
+			// nobody has a role, only fields without @hasRole resolve
+			HasRole: func(ctx context.Context, obj interface{}, next graphql.Resolver, role models.Role) (interface{}, error) {
+				return nil, NotAuthorized
+			},
+			EmailVerified: resolver.EmailVerifiedDirective,
+		},
+	}
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
+	srv.SetErrorPresenter(ErrorPresenter)
+	c := client.New(srv)
+
+	query := `query($representations: [_Any!]!) {
+		_entities(representations: $representations) { ... on User { id emailVerified } }
+	}`
+	representations := []map[string]interface{}{
+		{"__typename": "User", "id": "1"},
+		{"__typename": "User", "id": "2"},
+	}
+	var resp struct {
+		Entities []struct {
+			ID            string
+			EmailVerified bool
+		} `json:"_entities"`
+	}
+	c.MustPost(query, &resp, client.Var("representations", representations))
+	if len(resp.Entities) != 2 {
+		t.Fatalf("_entities = %+v, want 2 users", resp.Entities)
+	}
+	for i, want := range []bool{true, false} {
+		if resp.Entities[i].EmailVerified != want {
+			t.Errorf("user %s emailVerified = %v, want %v", resp.Entities[i].ID, resp.Entities[i].EmailVerified, want)
+		}
+	}
+
+	// the rest of the profile is still only for the user themselves
+	err := c.Post(`query($representations: [_Any!]!) {
+		_entities(representations: $representations) { ... on User { email } }
+	}`, &resp, client.Var("representations", representations[:1]))
+	if err == nil {
+		t.Errorf("email was resolved without a role")
+	}
+}
@@ -0,0 +1,203 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/KnightHacks/knighthacks_shared/auth"
+	"github.com/KnightHacks/knighthacks_shared/models"
+	"github.com/KnightHacks/knighthacks_users/cursor"
+	"github.com/KnightHacks/knighthacks_users/middleware"
+	"github.com/KnightHacks/knighthacks_users/oauth"
+	"github.com/KnightHacks/knighthacks_users/phonenumber"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/KnightHacks/knighthacks_users/validation"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"log"
+)
+
+// ErrorCode is sent to clients in the code extension of every error, clients should check
+// the code rather than the message as the codes never change
+type ErrorCode string
+
+const (
+	ErrorCodeNotFound         ErrorCode = "NOT_FOUND"
+	ErrorCodeAlreadyExists    ErrorCode = "ALREADY_EXISTS"
+	ErrorCodeForbidden        ErrorCode = "FORBIDDEN"
+	ErrorCodeValidationFailed ErrorCode = "VALIDATION_FAILED"
+	ErrorCodeConflict         ErrorCode = "CONFLICT"
+	ErrorCodeUnauthenticated  ErrorCode = "UNAUTHENTICATED"
+	ErrorCodeEmailNotVerified ErrorCode = "EMAIL_NOT_VERIFIED"
+	ErrorCodeInternal         ErrorCode = "INTERNAL"
+)
+
+// pgUniqueViolation is the postgres error code of a unique constraint violation
+const pgUniqueViolation = "23505"
+
+// Error is an error that is safe to show to clients, any other error is mapped to an
+// Error by the ErrorPresenter before it is sent
+type Error struct {
+	Code    ErrorCode
+	Message string
+	// Extensions are sent along with the code, they must not contain anything internal
+	Extensions map[string]interface{}
+}
+
+func NewError(code ErrorCode, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+var (
+	NotAuthenticated = NewError(ErrorCodeUnauthenticated, "you must be logged in")
+	NotAuthorized    = NewError(ErrorCodeForbidden, "you are not authorized to do this")
+	EmailNotVerified = NewError(ErrorCodeEmailNotVerified, "you must verify your email first")
+)
+
+// errorCodes maps the errors returned by the repository to the error shown to clients, the
+// repository's messages are already safe to show
+var errorCodes = map[error]ErrorCode{
+	repository.UserNotFound:               ErrorCodeNotFound,
+	repository.APIKeyNotFound:             ErrorCodeNotFound,
+	repository.OAuthIdentityNotFound:      ErrorCodeNotFound,
+	repository.UserAlreadyExists:          ErrorCodeAlreadyExists,
+	repository.EmailAlreadyInUse:          ErrorCodeAlreadyExists,
+	repository.EmailChangeNotValid:        ErrorCodeNotFound,
+	repository.EmailVerificationNotValid:  ErrorCodeNotFound,
+	repository.EmailAlreadyVerified:       ErrorCodeConflict,
+	repository.OAuthIdentityAlreadyLinked: ErrorCodeAlreadyExists,
+	repository.ProviderAlreadyLinked:      ErrorCodeAlreadyExists,
+	repository.UserNotDeleted:             ErrorCodeConflict,
+	repository.LastAdmin:                  ErrorCodeConflict,
+	repository.LastOAuthIdentity:          ErrorCodeConflict,
+	repository.RestoreWindowExpired:       ErrorCodeConflict,
+	repository.CannotMergeSameUser:        ErrorCodeValidationFailed,
+	repository.PronounNotFound:            ErrorCodeNotFound,
+	repository.CannotMergeSamePronouns:    ErrorCodeValidationFailed,
+	repository.RefreshTokenNotValid:       ErrorCodeUnauthenticated,
+	repository.RefreshTokenReused:         ErrorCodeUnauthenticated,
+	cursor.NotValid:                       ErrorCodeValidationFailed,
+	oauth.ProviderNotConfigured:           ErrorCodeValidationFailed,
+	oauth.RedirectNotAllowed:              ErrorCodeValidationFailed,
+	oauth.StateNotValid:                   ErrorCodeUnauthenticated,
+}
+
+// uniqueConstraintMessages are shown instead of the database's message when a unique
+// constraint is violated
+var uniqueConstraintMessages = map[string]string{
+	"users_email_uindex":        "a user with this email already exists",
+	"users_phone_number_uindex": "a user with this phone number already exists",
+}
+
+// ToError maps err to the error shown to clients, nil is returned if the error is not known
+// and must be hidden
+func ToError(err error) *Error {
+	var graphError *Error
+	if errors.As(err, &graphError) {
+		return graphError
+	}
+	for known, code := range errorCodes {
+		if errors.Is(err, known) {
+			return NewError(code, known.Error())
+		}
+	}
+
+	if errors.Is(err, phonenumber.InvalidPhoneNumber) {
+		return &Error{
+			Code:       ErrorCodeValidationFailed,
+			Message:    phonenumber.InvalidPhoneNumber.Error(),
+			Extensions: map[string]interface{}{"fields": validation.Errors{{Field: "phoneNumber", Message: "must be a valid phone number"}}},
+		}
+	}
+	var fieldErrors validation.Errors
+	if errors.As(err, &fieldErrors) {
+		return &Error{
+			Code:       ErrorCodeValidationFailed,
+			Message:    fieldErrors.Error(),
+			Extensions: map[string]interface{}{"fields": fieldErrors},
+		}
+	}
+	var conflict *repository.VersionConflictError
+	if errors.As(err, &conflict) {
+		return &Error{
+			Code:    ErrorCodeConflict,
+			Message: conflict.Error(),
+			Extensions: map[string]interface{}{
+				"expectedVersion": conflict.ExpectedVersion,
+				"currentVersion":  conflict.CurrentVersion,
+			},
+		}
+	}
+	var mergeConflict *repository.MergeConflictError
+	if errors.As(err, &mergeConflict) {
+		return &Error{
+			Code:       ErrorCodeConflict,
+			Message:    mergeConflict.Error(),
+			Extensions: map[string]interface{}{"conflicts": mergeConflict.Conflicts},
+		}
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return NewError(ErrorCodeNotFound, "not found")
+	}
+	var pgError *pgconn.PgError
+	if errors.As(err, &pgError) && pgError.Code == pgUniqueViolation {
+		message, ok := uniqueConstraintMessages[pgError.ConstraintName]
+		if !ok {
+			message = "already exists"
+		}
+		return NewError(ErrorCodeAlreadyExists, message)
+	}
+	// gqlgen wraps the errors of arguments that could not be read, the messages say what was wrong with them
+	var gqlError *gqlerror.Error
+	if errors.As(err, &gqlError) {
+		return NewError(ErrorCodeValidationFailed, gqlError.Message)
+	}
+	return nil
+}
+
+// ErrorPresenter sets the code of every error, unknown errors are hidden from the client
+// and logged with the request's id so they can still be found
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	requestId := middleware.RequestIDFromContext(ctx)
+	log.Printf("Error presented: request id = %s, err = %v\n", requestId, err)
+
+	presented := graphql.DefaultErrorPresenter(ctx, err)
+	graphError := ToError(err)
+	if graphError == nil {
+		graphError = &Error{
+			Code:       ErrorCodeInternal,
+			Message:    "internal server error",
+			Extensions: map[string]interface{}{"requestId": requestId},
+		}
+	}
+	presented.Message = graphError.Message
+	presented.Extensions = map[string]interface{}{"code": graphError.Code}
+	for key, value := range graphError.Extensions {
+		presented.Extensions[key] = value
+	}
+	return presented
+}
+
+// HasRoleErrors wraps the hasRole directive so the errors it returns itself have a code,
+// errors returned by the field's resolver are left untouched
+func HasRoleErrors(hasRole func(ctx context.Context, obj interface{}, next graphql.Resolver, role models.Role) (interface{}, error)) func(ctx context.Context, obj interface{}, next graphql.Resolver, role models.Role) (interface{}, error) {
+	return func(ctx context.Context, obj interface{}, next graphql.Resolver, role models.Role) (interface{}, error) {
+		resolved := false
+		res, err := hasRole(ctx, obj, func(ctx context.Context) (interface{}, error) {
+			resolved = true
+			return next(ctx)
+		}, role)
+		if err == nil || resolved {
+			return res, err
+		}
+		if claims, claimsErr := auth.UserClaimsFromContext(ctx); claimsErr != nil || claims == nil {
+			return nil, NotAuthenticated
+		}
+		return nil, NotAuthorized
+	}
+}
@@ -0,0 +1,169 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/KnightHacks/knighthacks_users/middleware"
+	"github.com/KnightHacks/knighthacks_users/phonenumber"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/KnightHacks/knighthacks_users/validation"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"reflect"
+	"testing"
+)
+
+func TestToError_ErrorCodes(t *testing.T) {
+	for known, code := range errorCodes {
+		t.Run(known.Error(), func(t *testing.T) {
+			got := ToError(fmt.Errorf("unable to do the thing: %w", known))
+			if got == nil {
+				t.Fatalf("ToError() = nil, want %s", code)
+			}
+			if got.Code != code || got.Message != known.Error() {
+				t.Errorf("ToError() = %s %q, want %s %q", got.Code, got.Message, code, known.Error())
+			}
+		})
+	}
+}
+
+func TestToError(t *testing.T) {
+	fieldErrors := validation.Errors{{Field: "email", Message: "must be a valid email"}}
+	conflicts := []repository.MergeConflict{{Table: "hackathon_applications", Key: "1"}}
+	tests := []struct {
+		name string
+		err  error
+		want *Error
+	}{
+		{
+			name: "error shown as is",
+			err:  fmt.Errorf("wrapped: %w", EmailNotVerified),
+			want: EmailNotVerified,
+		},
+		{
+			name: "invalid phone number",
+			err:  phonenumber.InvalidPhoneNumber,
+			want: &Error{
+				Code:       ErrorCodeValidationFailed,
+				Message:    "invalid phone number",
+				Extensions: map[string]interface{}{"fields": validation.Errors{{Field: "phoneNumber", Message: "must be a valid phone number"}}},
+			},
+		},
+		{
+			name: "field errors",
+			err:  fieldErrors,
+			want: &Error{
+				Code:       ErrorCodeValidationFailed,
+				Message:    "invalid input, email: must be a valid email",
+				Extensions: map[string]interface{}{"fields": fieldErrors},
+			},
+		},
+		{
+			name: "version conflict",
+			err:  &repository.VersionConflictError{ExpectedVersion: 1, CurrentVersion: 2},
+			want: &Error{
+				Code:       ErrorCodeConflict,
+				Message:    "user has been changed since version 1, the current version is 2",
+				Extensions: map[string]interface{}{"expectedVersion": 1, "currentVersion": 2},
+			},
+		},
+		{
+			name: "merge conflict",
+			err:  &repository.MergeConflictError{Conflicts: conflicts},
+			want: &Error{
+				Code:       ErrorCodeConflict,
+				Message:    "both users have rows that only one of them can keep: hackathon_applications 1",
+				Extensions: map[string]interface{}{"conflicts": conflicts},
+			},
+		},
+		{
+			name: "no rows",
+			err:  fmt.Errorf("unable to get user: %w", pgx.ErrNoRows),
+			want: NewError(ErrorCodeNotFound, "not found"),
+		},
+		{
+			name: "known unique constraint",
+			err:  &pgconn.PgError{Code: pgUniqueViolation, ConstraintName: "users_email_uindex", Message: "duplicate key value violates unique constraint"},
+			want: NewError(ErrorCodeAlreadyExists, "a user with this email already exists"),
+		},
+		{
+			name: "unknown unique constraint",
+			err:  &pgconn.PgError{Code: pgUniqueViolation, ConstraintName: "secret_uindex", Message: "duplicate key value violates unique constraint"},
+			want: NewError(ErrorCodeAlreadyExists, "already exists"),
+		},
+		{
+			name: "argument that could not be read",
+			err:  gqlerror.Errorf("age must be an int"),
+			want: NewError(ErrorCodeValidationFailed, "age must be an int"),
+		},
+		{
+			name: "other database error",
+			err:  &pgconn.PgError{Code: "42P01", Message: "relation \"users\" does not exist"},
+			want: nil,
+		},
+		{
+			name: "internal error",
+			err:  errors.New("dial tcp 10.0.0.1:5432: connection refused"),
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToError(tt.err); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToError() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorPresenter(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middleware.RequestIDContextKey, "request-1")
+	tests := []struct {
+		name           string
+		err            error
+		wantMessage    string
+		wantExtensions map[string]interface{}
+	}{
+		{
+			name:           "known error",
+			err:            repository.UserNotFound,
+			wantMessage:    repository.UserNotFound.Error(),
+			wantExtensions: map[string]interface{}{"code": ErrorCodeNotFound},
+		},
+		{
+			name:        "extensions are kept",
+			err:         &repository.VersionConflictError{ExpectedVersion: 3, CurrentVersion: 4},
+			wantMessage: "user has been changed since version 3, the current version is 4",
+			wantExtensions: map[string]interface{}{
+				"code":            ErrorCodeConflict,
+				"expectedVersion": 3,
+				"currentVersion":  4,
+			},
+		},
+		{
+			name:           "internal error is hidden",
+			err:            errors.New("dial tcp 10.0.0.1:5432: connection refused"),
+			wantMessage:    "internal server error",
+			wantExtensions: map[string]interface{}{"code": ErrorCodeInternal, "requestId": "request-1"},
+		},
+		{
+			name:           "internal database error is hidden",
+			err:            fmt.Errorf("unable to update user: %w", &pgconn.PgError{Code: "42P01", Message: "relation \"users\" does not exist"}),
+			wantMessage:    "internal server error",
+			wantExtensions: map[string]interface{}{"code": ErrorCodeInternal, "requestId": "request-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ErrorPresenter(ctx, tt.err)
+			if got.Message != tt.wantMessage {
+				t.Errorf("ErrorPresenter() message = %q, want %q", got.Message, tt.wantMessage)
+			}
+			if !reflect.DeepEqual(got.Extensions, tt.wantExtensions) {
+				t.Errorf("ErrorPresenter() extensions = %v, want %v", got.Extensions, tt.wantExtensions)
+			}
+		})
+	}
+}
@@ -124,7 +124,7 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 				if err != nil {
 					return fmt.Errorf(`unmarshalling param 0 for findUserByOAuthUIDAndOAuthProvider(): %w`, err)
 				}
-				id1, err := ec.unmarshalNProvider2githubᚗcomᚋKnightHacksᚋknighthacks_sharedᚋmodelsᚐProvider(ctx, rep["oAuth"].(map[string]interface{})["provider"])
+				id1, err := ec.unmarshalNOAuthProvider2githubᚗcomᚋKnightHacksᚋknighthacks_usersᚋgraphᚋmodelᚐOAuthProvider(ctx, rep["oAuth"].(map[string]interface{})["provider"])
 				if err != nil {
 					return fmt.Errorf(`unmarshalling param 1 for findUserByOAuthUIDAndOAuthProvider(): %w`, err)
 				}
@@ -0,0 +1,90 @@
+package graph
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/KnightHacks/knighthacks_users/dataloader"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"time"
+)
+
+const (
+	LoadersContextKey = "Loaders"
+
+	// loaderWait is how long a loader waits for more keys before fetching, the fields of a page
+	// of users are all resolved well within this
+	loaderWait = time.Millisecond
+	// loaderMaxBatch is the most keys fetched in one query
+	loaderMaxBatch = 500
+	// loaderTimeout is the longest a batch is fetched for, it isn't cancelled with the request
+	// that started it as the other requests waiting on it would fail too
+	loaderTimeout = 10 * time.Second
+)
+
+// Loaders batch the lookups made while resolving an operation, e.g. the mailing address of every
+// user in users(first: 20) is fetched with one query instead of twenty
+type Loaders struct {
+	Users            *dataloader.Loader[string, *model.User]
+	ApplicationUsers *dataloader.Loader[string, *model.User]
+	OAuthUsers       *dataloader.Loader[model.OAuth, *model.User]
+	OAuth            *dataloader.Loader[string, *model.OAuth]
+	MailingAddresses *dataloader.Loader[string, *model.MailingAddress]
+	MLHTerms         *dataloader.Loader[string, *model.MLHTerms]
+	EducationInfo    *dataloader.Loader[string, *model.EducationInfo]
+	APIKeys          *dataloader.Loader[string, []*model.APIKey]
+}
+
+func NewLoaders(repo repository.Repository) *Loaders {
+	return &Loaders{
+		Users:            dataloader.New(repo.GetUsersByIDs, loaderWait, loaderMaxBatch, loaderTimeout),
+		ApplicationUsers: dataloader.New(repo.GetUsersByApplicationIDs, loaderWait, loaderMaxBatch, loaderTimeout),
+		OAuthUsers:       dataloader.New(repo.GetUsersByOAuthIdentities, loaderWait, loaderMaxBatch, loaderTimeout),
+		OAuth:            dataloader.New(repo.GetOAuths, loaderWait, loaderMaxBatch, loaderTimeout),
+		MailingAddresses: dataloader.New(repo.GetMailingAddresses, loaderWait, loaderMaxBatch, loaderTimeout),
+		MLHTerms:         dataloader.New(repo.GetMLHTerms, loaderWait, loaderMaxBatch, loaderTimeout),
+		EducationInfo:    dataloader.New(repo.GetEducationInfos, loaderWait, loaderMaxBatch, loaderTimeout),
+		APIKeys:          dataloader.New(repo.GetAPIKeysByUserIDs, loaderWait, loaderMaxBatch, loaderTimeout),
+	}
+}
+
+// LoadersMiddleware gives each operation its own loaders, values are never shared between
+// operations so one user can't see what was loaded for another
+func (r *Resolver) LoadersMiddleware(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	return next(context.WithValue(ctx, LoadersContextKey, NewLoaders(r.Repository)))
+}
+
+// Loaders returns the loaders of the operation, new loaders are made if the operation doesn't
+// have any so nothing is batched but everything still resolves
+func (r *Resolver) Loaders(ctx context.Context) *Loaders {
+	if loaders, ok := ctx.Value(LoadersContextKey).(*Loaders); ok {
+		return loaders
+	}
+	return NewLoaders(r.Repository)
+}
+
+// LoadUser loads the user by their id, repository.UserNotFound is returned if the user doesn't
+// exist
+func (r *Resolver) LoadUser(ctx context.Context, id string) (*model.User, error) {
+	user, err := r.Loaders(ctx).Users.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, repository.UserNotFound
+	}
+	return user, nil
+}
+
+// LoadUserByOAuth loads the user the OAuth identity is linked to, repository.UserNotFound is
+// returned if it isn't linked to anyone
+func (r *Resolver) LoadUserByOAuth(ctx context.Context, oAuth model.OAuth) (*model.User, error) {
+	user, err := r.Loaders(ctx).OAuthUsers.Load(ctx, oAuth)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, repository.UserNotFound
+	}
+	return user, nil
+}
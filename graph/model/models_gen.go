@@ -13,32 +13,89 @@ import (
 
 type Connection interface {
 	IsConnection()
+	GetTotalCount() *int
+	GetPageInfo() *models.PageInfo
 }
 
 type APIKey struct {
-	Created time.Time `json:"created"`
-	Key     string    `json:"key"`
+	ID         string        `json:"id"`
+	Name       string        `json:"name"`
+	Scopes     []APIKeyScope `json:"scopes"`
+	Created    time.Time     `json:"created"`
+	ExpiresAt  *time.Time    `json:"expiresAt,omitempty"`
+	LastUsedAt *time.Time    `json:"lastUsedAt,omitempty"`
+}
+
+type AuditLogConnection struct {
+	TotalCount int              `json:"totalCount"`
+	PageInfo   *UsersPageInfo   `json:"pageInfo"`
+	Entries    []*AuditLogEntry `json:"entries"`
+}
+
+// A change made to a user, entries can never be changed or removed
+type AuditLogEntry struct {
+	ID string `json:"id"`
+	// The user that made the change, null when the change was made by the service itself
+	ActorID *string `json:"actorId,omitempty"`
+	// The role the actor had when they made the change
+	ActorRole *models.Role   `json:"actorRole,omitempty"`
+	UserID    string         `json:"userId"`
+	Operation AuditOperation `json:"operation"`
+	Changes   []*FieldChange `json:"changes"`
+	RequestID *string        `json:"requestId,omitempty"`
+	Created   time.Time      `json:"created"`
+}
+
+// Everything stored about a user
+type DataExport struct {
+	GeneratedAt time.Time `json:"generatedAt"`
+	// A JSON document containing every row tied to the user
+	Data string `json:"data"`
+}
+
+// Everything that was removed when a user was deleted
+type DeletionReport struct {
+	// The rows that were deleted from each table, tables without any rows are left out
+	Deleted []*TableRowCount `json:"deleted"`
+	// The rows that were kept without anything tying them to the user, such as check-ins
+	Anonymized []*TableRowCount `json:"anonymized"`
+	// Set when the user was only marked as deleted, an admin can restore the user until then
+	RestorableUntil *time.Time `json:"restorableUntil,omitempty"`
+}
+
+// Two users that are likely the same person, use mergeUsers to combine them
+type DuplicateUsers struct {
+	User      *User             `json:"user"`
+	Duplicate *User             `json:"duplicate"`
+	Reasons   []DuplicateReason `json:"reasons"`
 }
 
 type EducationInfo struct {
 	Name           string        `json:"name"`
 	GraduationDate time.Time     `json:"graduationDate"`
 	Major          string        `json:"major"`
-	Level          *LevelOfStudy `json:"level"`
+	Level          *LevelOfStudy `json:"level,omitempty"`
 }
 
 type EducationInfoInput struct {
 	Name           string        `json:"name"`
 	GraduationDate time.Time     `json:"graduationDate"`
 	Major          string        `json:"major"`
-	Level          *LevelOfStudy `json:"level"`
+	Level          *LevelOfStudy `json:"level,omitempty"`
 }
 
 type EducationInfoUpdate struct {
-	Name           *string       `json:"name"`
-	GraduationDate *time.Time    `json:"graduationDate"`
-	Major          *string       `json:"major"`
-	Level          *LevelOfStudy `json:"level"`
+	Name           *string       `json:"name,omitempty"`
+	GraduationDate *time.Time    `json:"graduationDate,omitempty"`
+	Major          *string       `json:"major,omitempty"`
+	Level          *LevelOfStudy `json:"level,omitempty"`
+}
+
+// The value of a field before and after a change, personal information is always redacted
+type FieldChange struct {
+	Field  string  `json:"field"`
+	Before *string `json:"before,omitempty"`
+	After  *string `json:"after,omitempty"`
 }
 
 type HackathonApplication struct {
@@ -51,11 +108,13 @@ func (HackathonApplication) IsEntity() {}
 type LoginPayload struct {
 	// If false then you must register immediately following this. Else, you are logged in and have access to your own user.
 	AccountExists bool    `json:"accountExists"`
-	User          *User   `json:"user"`
-	AccessToken   *string `json:"accessToken"`
-	RefreshToken  *string `json:"refreshToken"`
+	User          *User   `json:"user,omitempty"`
+	AccessToken   *string `json:"accessToken,omitempty"`
+	RefreshToken  *string `json:"refreshToken,omitempty"`
 	// Not null when accountExists is false, use this in registration
-	EncryptedOAuthAccessToken *string `json:"encryptedOAuthAccessToken"`
+	EncryptedOAuthAccessToken *string `json:"encryptedOAuthAccessToken,omitempty"`
+	// Not null when accountExists is false, what the OAuth provider knows about the user to prefill registration with
+	SuggestedProfile *SuggestedProfile `json:"suggestedProfile,omitempty"`
 }
 
 type MLHTerms struct {
@@ -71,9 +130,9 @@ type MLHTermsInput struct {
 }
 
 type MLHTermsUpdate struct {
-	SendMessages  *bool `json:"sendMessages"`
-	CodeOfConduct *bool `json:"codeOfConduct"`
-	ShareInfo     *bool `json:"shareInfo"`
+	SendMessages  *bool `json:"sendMessages,omitempty"`
+	CodeOfConduct *bool `json:"codeOfConduct,omitempty"`
+	ShareInfo     *bool `json:"shareInfo,omitempty"`
 }
 
 type MailingAddress struct {
@@ -93,11 +152,23 @@ type MailingAddressInput struct {
 }
 
 type MailingAddressUpdate struct {
-	Country      *string  `json:"country"`
-	State        *string  `json:"state"`
-	City         *string  `json:"city"`
-	PostalCode   *string  `json:"postalCode"`
-	AddressLines []string `json:"addressLines"`
+	Country      *string  `json:"country,omitempty"`
+	State        *string  `json:"state,omitempty"`
+	City         *string  `json:"city,omitempty"`
+	PostalCode   *string  `json:"postalCode,omitempty"`
+	AddressLines []string `json:"addressLines,omitempty"`
+}
+
+type NewAPIKey struct {
+	Name      string        `json:"name"`
+	Scopes    []APIKeyScope `json:"scopes"`
+	ExpiresAt *time.Time    `json:"expiresAt,omitempty"`
+}
+
+type NewAPIKeyPayload struct {
+	APIKey *APIKey `json:"apiKey"`
+	// The secret key, this is only ever shown once. Only a hash of it is stored.
+	Key string `json:"key"`
 }
 
 type NewUser struct {
@@ -105,20 +176,28 @@ type NewUser struct {
 	LastName          string               `json:"lastName"`
 	Email             string               `json:"email"`
 	PhoneNumber       string               `json:"phoneNumber"`
-	Pronouns          *PronounsInput       `json:"pronouns"`
-	Age               *int                 `json:"age"`
-	MailingAddress    *MailingAddressInput `json:"mailingAddress"`
-	Mlh               *MLHTermsInput       `json:"mlh"`
-	ShirtSize         *ShirtSize           `json:"shirtSize"`
-	YearsOfExperience *float64             `json:"yearsOfExperience"`
-	EducationInfo     *EducationInfoInput  `json:"educationInfo"`
-	Gender            *string              `json:"gender"`
-	Race              []Race               `json:"race"`
+	Pronouns          *PronounsInput       `json:"pronouns,omitempty"`
+	Age               *int                 `json:"age,omitempty"`
+	MailingAddress    *MailingAddressInput `json:"mailingAddress,omitempty"`
+	Mlh               *MLHTermsInput       `json:"mlh,omitempty"`
+	ShirtSize         *ShirtSize           `json:"shirtSize,omitempty"`
+	YearsOfExperience *float64             `json:"yearsOfExperience,omitempty"`
+	EducationInfo     *EducationInfoInput  `json:"educationInfo,omitempty"`
+	Gender            *string              `json:"gender,omitempty"`
+	Race              []Race               `json:"race,omitempty"`
 }
 
 type OAuth struct {
-	Provider models.Provider `json:"provider"`
-	UID      string          `json:"uid"`
+	Provider OAuthProvider `json:"provider"`
+	UID      string        `json:"uid"`
+}
+
+// Pronouns that are offered to users to pick from
+type PronounOption struct {
+	ID       string    `json:"id"`
+	Pronouns *Pronouns `json:"pronouns"`
+	// Retired options are no longer offered, users that picked them keep them
+	Retired bool `json:"retired"`
 }
 
 // Example:
@@ -127,11 +206,24 @@ type OAuth struct {
 type Pronouns struct {
 	Subjective string `json:"subjective"`
 	Objective  string `json:"objective"`
+	// Example: his
+	Possessive *string `json:"possessive,omitempty"`
+	// The user would rather be asked, subjective holds whatever they wrote e.g. "ask me" and objective is empty
+	AskMe bool `json:"askMe"`
 }
 
+// Pronouns are stored lowercase without extra whitespace, so " He " and "he" are the same pronoun
 type PronounsInput struct {
-	Subjective string `json:"subjective"`
-	Objective  string `json:"objective"`
+	Subjective string  `json:"subjective"`
+	Objective  string  `json:"objective"`
+	Possessive *string `json:"possessive,omitempty"`
+	AskMe      bool    `json:"askMe"`
+}
+
+type RefreshPayload struct {
+	AccessToken string `json:"accessToken"`
+	// The refresh token that replaces the one used, the old refresh token can no longer be used
+	RefreshToken string `json:"refreshToken"`
 }
 
 type RegistrationPayload struct {
@@ -140,52 +232,310 @@ type RegistrationPayload struct {
 	RefreshToken string `json:"refreshToken"`
 }
 
+// An audit record of an admin changing a user's role
+type RoleChange struct {
+	PreviousRole models.Role `json:"previousRole"`
+	Role         models.Role `json:"role"`
+	// The admin that made the change, null if they no longer exist
+	ChangedBy *User     `json:"changedBy,omitempty"`
+	ChangedAt time.Time `json:"changedAt"`
+}
+
+// A logged in device, every login creates a new session which lasts until it is logged out
+// or its refresh token expires
+type Session struct {
+	ID        string    `json:"id"`
+	UserAgent *string   `json:"userAgent,omitempty"`
+	Created   time.Time `json:"created"`
+	LastUsed  time.Time `json:"lastUsed"`
+}
+
+type SuggestedProfile struct {
+	FirstName *string `json:"firstName,omitempty"`
+	LastName  *string `json:"lastName,omitempty"`
+	Email     *string `json:"email,omitempty"`
+	AvatarURL *string `json:"avatarUrl,omitempty"`
+	// Only set when logging in with GitHub
+	GithubLogin *string `json:"githubLogin,omitempty"`
+}
+
+type TableRowCount struct {
+	Table string `json:"table"`
+	Rows  int    `json:"rows"`
+}
+
 type UpdatedUser struct {
-	FirstName         *string               `json:"firstName"`
-	LastName          *string               `json:"lastName"`
-	Email             *string               `json:"email"`
-	PhoneNumber       *string               `json:"phoneNumber"`
-	Pronouns          *PronounsInput        `json:"pronouns"`
-	Age               *int                  `json:"age"`
-	MailingAddress    *MailingAddressUpdate `json:"mailingAddress"`
-	Mlh               *MLHTermsUpdate       `json:"mlh"`
-	ShirtSize         *ShirtSize            `json:"shirtSize"`
-	YearsOfExperience *float64              `json:"yearsOfExperience"`
-	EducationInfo     *EducationInfoUpdate  `json:"educationInfo"`
-	Gender            *string               `json:"gender"`
-	Race              []Race                `json:"race"`
+	FirstName         *string               `json:"firstName,omitempty"`
+	LastName          *string               `json:"lastName,omitempty"`
+	Email             *string               `json:"email,omitempty"`
+	PhoneNumber       *string               `json:"phoneNumber,omitempty"`
+	Pronouns          *PronounsInput        `json:"pronouns,omitempty"`
+	Age               *int                  `json:"age,omitempty"`
+	MailingAddress    *MailingAddressUpdate `json:"mailingAddress,omitempty"`
+	Mlh               *MLHTermsUpdate       `json:"mlh,omitempty"`
+	ShirtSize         *ShirtSize            `json:"shirtSize,omitempty"`
+	YearsOfExperience *float64              `json:"yearsOfExperience,omitempty"`
+	EducationInfo     *EducationInfoUpdate  `json:"educationInfo,omitempty"`
+	Gender            *string               `json:"gender,omitempty"`
+	Race              []Race                `json:"race,omitempty"`
 }
 
 type User struct {
+	ID          string      `json:"id"`
+	FirstName   string      `json:"firstName"`
+	LastName    string      `json:"lastName"`
+	FullName    string      `json:"fullName"`
+	Email       string      `json:"email"`
+	PhoneNumber string      `json:"phoneNumber"`
+	Pronouns    *Pronouns   `json:"pronouns,omitempty"`
+	Age         *int        `json:"age,omitempty"`
+	Role        models.Role `json:"role"`
+	Gender      *string     `json:"gender,omitempty"`
+	Race        []Race      `json:"race,omitempty"`
+	// The identity the user first registered with
+	OAuth *OAuth `json:"oAuth"`
+	// Every identity linked to the user, the user can login with any of them
+	Identities        []*OAuth        `json:"identities"`
+	MailingAddress    *MailingAddress `json:"mailingAddress,omitempty"`
+	Mlh               *MLHTerms       `json:"mlh,omitempty"`
+	ShirtSize         *ShirtSize      `json:"shirtSize,omitempty"`
+	YearsOfExperience *float64        `json:"yearsOfExperience,omitempty"`
+	EducationInfo     *EducationInfo  `json:"educationInfo,omitempty"`
+	// Incremented every time the user is changed, pass it to updateUser as expectedVersion to avoid
+	// overwriting someone else's changes
+	Version int `json:"version"`
+	// The email the user is changing to, it replaces email once it has been confirmed
+	PendingEmail *string `json:"pendingEmail,omitempty"`
+	// Whether the user has proven they own their email, either through their OAuth provider or by
+	// following the link sent to it. Anyone can read it so other services resolving the User entity,
+	// such as the hackathon service before accepting an application, can require it
+	EmailVerified bool `json:"emailVerified"`
+	// The fields the user kept from their OAuth provider's profile when they registered
+	PrefilledFields []ProfileField `json:"prefilledFields"`
+	APIKeys         []*APIKey      `json:"apiKeys"`
+	RoleHistory     []*RoleChange  `json:"roleHistory"`
+	// Every version of the user's profile, oldest first
+	History []*UserVersion `json:"history"`
+}
+
+func (User) IsEntity() {}
+
+type UserEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *User  `json:"node"`
+}
+
+// Users match every field that is set, list fields match users with any of the values
+type UserFilter struct {
+	Roles         []models.Role  `json:"roles,omitempty"`
+	ShirtSizes    []ShirtSize    `json:"shirtSizes,omitempty"`
+	LevelsOfStudy []LevelOfStudy `json:"levelsOfStudy,omitempty"`
+	// Matches schools with the name in them, ignoring case
+	SchoolName     *string `json:"schoolName,omitempty"`
+	GraduationYear *int    `json:"graduationYear,omitempty"`
+	// The country and state of the user's mailing address, ignoring case
+	Country *string `json:"country,omitempty"`
+	State   *string `json:"state,omitempty"`
+	MinAge  *int    `json:"minAge,omitempty"`
+	MaxAge  *int    `json:"maxAge,omitempty"`
+	// The user has agreed to MLH's code of conduct and to share their info with MLH
+	AcceptedMlhTerms *bool `json:"acceptedMlhTerms,omitempty"`
+	// The user has an API key that hasn't expired
+	HasAPIKey *bool `json:"hasAPIKey,omitempty"`
+	// When the user registered, users that registered before registration times were recorded
+	// never match
+	RegisteredAfter  *time.Time `json:"registeredAfter,omitempty"`
+	RegisteredBefore *time.Time `json:"registeredBefore,omitempty"`
+}
+
+// A copy of a user's profile at a point in time, this never changes once saved
+type UserSnapshot struct {
 	ID                string          `json:"id"`
 	FirstName         string          `json:"firstName"`
 	LastName          string          `json:"lastName"`
-	FullName          string          `json:"fullName"`
 	Email             string          `json:"email"`
 	PhoneNumber       string          `json:"phoneNumber"`
-	Pronouns          *Pronouns       `json:"pronouns"`
-	Age               *int            `json:"age"`
+	Pronouns          *Pronouns       `json:"pronouns,omitempty"`
+	Age               *int            `json:"age,omitempty"`
 	Role              models.Role     `json:"role"`
-	Gender            *string         `json:"gender"`
-	Race              []Race          `json:"race"`
-	OAuth             *OAuth          `json:"oAuth"`
-	MailingAddress    *MailingAddress `json:"mailingAddress"`
-	Mlh               *MLHTerms       `json:"mlh"`
-	ShirtSize         *ShirtSize      `json:"shirtSize"`
-	YearsOfExperience *float64        `json:"yearsOfExperience"`
-	EducationInfo     *EducationInfo  `json:"educationInfo"`
-	APIKey            *APIKey         `json:"apiKey"`
+	Gender            *string         `json:"gender,omitempty"`
+	Race              []Race          `json:"race,omitempty"`
+	MailingAddress    *MailingAddress `json:"mailingAddress,omitempty"`
+	Mlh               *MLHTerms       `json:"mlh,omitempty"`
+	ShirtSize         *ShirtSize      `json:"shirtSize,omitempty"`
+	YearsOfExperience *float64        `json:"yearsOfExperience,omitempty"`
+	EducationInfo     *EducationInfo  `json:"educationInfo,omitempty"`
 }
 
-func (User) IsEntity() {}
+// The user's profile as it was saved by a registration or an update
+type UserVersion struct {
+	Version int           `json:"version"`
+	Created time.Time     `json:"created"`
+	User    *UserSnapshot `json:"user"`
+}
 
 type UsersConnection struct {
-	TotalCount int              `json:"totalCount"`
-	PageInfo   *models.PageInfo `json:"pageInfo"`
-	Users      []*User          `json:"users"`
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *UsersPageInfo `json:"pageInfo"`
+	Users      []*User        `json:"users"`
+	Edges      []*UserEdge    `json:"edges"`
 }
 
-func (UsersConnection) IsConnection() {}
+type UsersPageInfo struct {
+	StartCursor     string `json:"startCursor"`
+	EndCursor       string `json:"endCursor"`
+	HasNextPage     bool   `json:"hasNextPage"`
+	HasPreviousPage bool   `json:"hasPreviousPage"`
+}
+
+// The scopes an API key is allowed to act with, a key can never act with more
+// permissions than the user that owns it
+type APIKeyScope string
+
+const (
+	// read only access to the owner's profile, including the fields only the owner can see
+	APIKeyScopeReadProfile APIKeyScope = "READ_PROFILE"
+	// used by the check-in scanners, only the fields every logged in user can see are readable
+	APIKeyScopeCheckIn APIKeyScope = "CHECK_IN"
+	// used for exporting attendee data, only usable if the owner is an ADMIN
+	APIKeyScopeAdminExport APIKeyScope = "ADMIN_EXPORT"
+)
+
+var AllAPIKeyScope = []APIKeyScope{
+	APIKeyScopeReadProfile,
+	APIKeyScopeCheckIn,
+	APIKeyScopeAdminExport,
+}
+
+func (e APIKeyScope) IsValid() bool {
+	switch e {
+	case APIKeyScopeReadProfile, APIKeyScopeCheckIn, APIKeyScopeAdminExport:
+		return true
+	}
+	return false
+}
+
+func (e APIKeyScope) String() string {
+	return string(e)
+}
+
+func (e *APIKeyScope) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = APIKeyScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid APIKeyScope", str)
+	}
+	return nil
+}
+
+func (e APIKeyScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AuditOperation string
+
+const (
+	AuditOperationCreateUser     AuditOperation = "CREATE_USER"
+	AuditOperationUpdateUser     AuditOperation = "UPDATE_USER"
+	AuditOperationSetRole        AuditOperation = "SET_ROLE"
+	AuditOperationDeleteUser     AuditOperation = "DELETE_USER"
+	AuditOperationRestoreUser    AuditOperation = "RESTORE_USER"
+	AuditOperationEraseUser      AuditOperation = "ERASE_USER"
+	AuditOperationMergeUsers     AuditOperation = "MERGE_USERS"
+	AuditOperationLinkProvider   AuditOperation = "LINK_PROVIDER"
+	AuditOperationUnlinkProvider AuditOperation = "UNLINK_PROVIDER"
+)
+
+var AllAuditOperation = []AuditOperation{
+	AuditOperationCreateUser,
+	AuditOperationUpdateUser,
+	AuditOperationSetRole,
+	AuditOperationDeleteUser,
+	AuditOperationRestoreUser,
+	AuditOperationEraseUser,
+	AuditOperationMergeUsers,
+	AuditOperationLinkProvider,
+	AuditOperationUnlinkProvider,
+}
+
+func (e AuditOperation) IsValid() bool {
+	switch e {
+	case AuditOperationCreateUser, AuditOperationUpdateUser, AuditOperationSetRole, AuditOperationDeleteUser, AuditOperationRestoreUser, AuditOperationEraseUser, AuditOperationMergeUsers, AuditOperationLinkProvider, AuditOperationUnlinkProvider:
+		return true
+	}
+	return false
+}
+
+func (e AuditOperation) String() string {
+	return string(e)
+}
+
+func (e *AuditOperation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditOperation", str)
+	}
+	return nil
+}
+
+func (e AuditOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type DuplicateReason string
+
+const (
+	// the emails are the same once lowercased and any +tag is removed
+	DuplicateReasonEmail DuplicateReason = "EMAIL"
+	// the last 10 digits of the phone numbers are the same
+	DuplicateReasonPhoneNumber DuplicateReason = "PHONE_NUMBER"
+	// the full names are similar
+	DuplicateReasonName DuplicateReason = "NAME"
+)
+
+var AllDuplicateReason = []DuplicateReason{
+	DuplicateReasonEmail,
+	DuplicateReasonPhoneNumber,
+	DuplicateReasonName,
+}
+
+func (e DuplicateReason) IsValid() bool {
+	switch e {
+	case DuplicateReasonEmail, DuplicateReasonPhoneNumber, DuplicateReasonName:
+		return true
+	}
+	return false
+}
+
+func (e DuplicateReason) String() string {
+	return string(e)
+}
+
+func (e *DuplicateReason) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DuplicateReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DuplicateReason", str)
+	}
+	return nil
+}
+
+func (e DuplicateReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
 
 type LevelOfStudy string
 
@@ -236,6 +586,95 @@ func (e LevelOfStudy) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type OAuthProvider string
+
+const (
+	OAuthProviderGithub    OAuthProvider = "GITHUB"
+	OAuthProviderGmail     OAuthProvider = "GMAIL"
+	OAuthProviderDiscord   OAuthProvider = "DISCORD"
+	OAuthProviderMicrosoft OAuthProvider = "MICROSOFT"
+)
+
+var AllOAuthProvider = []OAuthProvider{
+	OAuthProviderGithub,
+	OAuthProviderGmail,
+	OAuthProviderDiscord,
+	OAuthProviderMicrosoft,
+}
+
+func (e OAuthProvider) IsValid() bool {
+	switch e {
+	case OAuthProviderGithub, OAuthProviderGmail, OAuthProviderDiscord, OAuthProviderMicrosoft:
+		return true
+	}
+	return false
+}
+
+func (e OAuthProvider) String() string {
+	return string(e)
+}
+
+func (e *OAuthProvider) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OAuthProvider(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OAuthProvider", str)
+	}
+	return nil
+}
+
+func (e OAuthProvider) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// A field of the user's profile that can be prefilled from their OAuth provider
+type ProfileField string
+
+const (
+	ProfileFieldFirstName ProfileField = "FIRST_NAME"
+	ProfileFieldLastName  ProfileField = "LAST_NAME"
+	ProfileFieldEmail     ProfileField = "EMAIL"
+)
+
+var AllProfileField = []ProfileField{
+	ProfileFieldFirstName,
+	ProfileFieldLastName,
+	ProfileFieldEmail,
+}
+
+func (e ProfileField) IsValid() bool {
+	switch e {
+	case ProfileFieldFirstName, ProfileFieldLastName, ProfileFieldEmail:
+		return true
+	}
+	return false
+}
+
+func (e ProfileField) String() string {
+	return string(e)
+}
+
+func (e *ProfileField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProfileField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProfileField", str)
+	}
+	return nil
+}
+
+func (e ProfileField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Race string
 
 const (
@@ -335,3 +774,57 @@ func (e *ShirtSize) UnmarshalGQL(v interface{}) error {
 func (e ShirtSize) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// Users with the same value are ordered by id, users without a value come first
+type UserSort string
+
+const (
+	UserSortID UserSort = "ID"
+	// The users that registered last come first, users that registered before registration times
+	// were recorded come last
+	UserSortNewest         UserSort = "NEWEST"
+	UserSortFirstName      UserSort = "FIRST_NAME"
+	UserSortLastName       UserSort = "LAST_NAME"
+	UserSortAge            UserSort = "AGE"
+	UserSortSchoolName     UserSort = "SCHOOL_NAME"
+	UserSortGraduationDate UserSort = "GRADUATION_DATE"
+)
+
+var AllUserSort = []UserSort{
+	UserSortID,
+	UserSortNewest,
+	UserSortFirstName,
+	UserSortLastName,
+	UserSortAge,
+	UserSortSchoolName,
+	UserSortGraduationDate,
+}
+
+func (e UserSort) IsValid() bool {
+	switch e {
+	case UserSortID, UserSortNewest, UserSortFirstName, UserSortLastName, UserSortAge, UserSortSchoolName, UserSortGraduationDate:
+		return true
+	}
+	return false
+}
+
+func (e UserSort) String() string {
+	return string(e)
+}
+
+func (e *UserSort) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UserSort(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UserSort", str)
+	}
+	return nil
+}
+
+func (e UserSort) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,18 @@
+package model
+
+// IsEmpty returns true if the update doesn't change any field of the user
+func (u *UpdatedUser) IsEmpty() bool {
+	return u.FirstName == nil &&
+		u.LastName == nil &&
+		u.Email == nil &&
+		u.PhoneNumber == nil &&
+		u.Pronouns == nil &&
+		u.Age == nil &&
+		u.MailingAddress == nil &&
+		u.Mlh == nil &&
+		u.ShirtSize == nil &&
+		u.YearsOfExperience == nil &&
+		u.EducationInfo == nil &&
+		u.Gender == nil &&
+		u.Race == nil
+}
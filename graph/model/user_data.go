@@ -0,0 +1,70 @@
+package model
+
+import "time"
+
+// UserData is every row stored about a user, it is marshalled to JSON as is when a
+// user exports their data so every field must have a json tag
+type UserData struct {
+	User                  *User                      `json:"user"`
+	OAuthIdentities       []*OAuth                   `json:"oAuthIdentities"`
+	MailingAddress        *MailingAddress            `json:"mailingAddress"`
+	MLHTerms              *MLHTerms                  `json:"mlhTerms"`
+	EducationInfo         *EducationInfo             `json:"educationInfo"`
+	APIKeys               []*APIKey                  `json:"apiKeys"`
+	Sessions              []*Session                 `json:"sessions"`
+	RoleHistory           []*RoleChange              `json:"roleHistory"`
+	AuditLog              []*AuditLogEntry           `json:"auditLog"`
+	Versions              []*UserVersion             `json:"versions"`
+	EmailChanges          []*EmailChangeRow          `json:"emailChanges"`
+	EmailVerifications    []*EmailVerificationRow    `json:"emailVerifications"`
+	HackathonApplications []*HackathonApplicationRow `json:"hackathonApplications"`
+	HackathonCheckIns     []*HackathonCheckInRow     `json:"hackathonCheckIns"`
+	EventAttendance       []*EventAttendanceRow      `json:"eventAttendance"`
+	Meals                 []*MealsRow                `json:"meals"`
+}
+
+// EmailChangeRow is a row of the email_changes table, the token is never exported
+type EmailChangeRow struct {
+	NewEmail    string     `json:"newEmail"`
+	Created     time.Time  `json:"created"`
+	ExpiresAt   time.Time  `json:"expiresAt"`
+	ConfirmedAt *time.Time `json:"confirmedAt"`
+}
+
+// EmailVerificationRow is a row of the email_verifications table, the token is never exported
+type EmailVerificationRow struct {
+	Email     string    `json:"email"`
+	Created   time.Time `json:"created"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// HackathonApplicationRow is a row of the hackathon_applications table, the table is owned
+// by the hackathon service so it has no GraphQL type in this service
+type HackathonApplicationRow struct {
+	ID                    string     `json:"id"`
+	HackathonID           string     `json:"hackathonId"`
+	WhyAttend             []string   `json:"whyAttend"`
+	WhatDoYouWantToLearn  []string   `json:"whatDoYouWantToLearn"`
+	ShareInfoWithSponsors bool       `json:"shareInfoWithSponsors"`
+	ApplicationStatus     string     `json:"applicationStatus"`
+	CreatedTime           time.Time  `json:"createdTime"`
+	StatusChangeTime      *time.Time `json:"statusChangeTime"`
+}
+
+// HackathonCheckInRow is a row of the hackathon_checkin table
+type HackathonCheckInRow struct {
+	HackathonID string    `json:"hackathonId"`
+	Time        time.Time `json:"time"`
+}
+
+// EventAttendanceRow is a row of the event_attendance table
+type EventAttendanceRow struct {
+	EventID string    `json:"eventId"`
+	Time    time.Time `json:"time"`
+}
+
+// MealsRow is a row of the meals table
+type MealsRow struct {
+	HackathonID string   `json:"hackathonId"`
+	Meals       []string `json:"meals"`
+}
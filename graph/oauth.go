@@ -0,0 +1,99 @@
+package graph
+
+import (
+	"context"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/oauth"
+	"log"
+	"strings"
+)
+
+// StartOAuthLogin remembers a new state for the provider and returns the link the user must
+// be sent to to login, the redirect must be on one of the allowed origins
+func (r *Resolver) StartOAuthLogin(ctx context.Context, provider model.OAuthProvider, redirect *string) (string, error) {
+	oAuthProvider, err := r.OAuthProviders.Get(provider)
+	if err != nil {
+		return "", err
+	}
+	if redirect != nil {
+		if err = oauth.ValidateRedirect(*redirect, r.AllowedRedirectOrigins); err != nil {
+			return "", err
+		}
+	}
+	state, err := oauth.NewState(provider, redirect)
+	if err != nil {
+		return "", err
+	}
+	if err = r.OAuthStates.Save(ctx, state); err != nil {
+		return "", err
+	}
+	return oAuthProvider.AuthCodeURL(state), nil
+}
+
+// ExchangeOAuthCode takes the state the user was sent to the provider with, exchanges the code
+// for an access token and returns the provider's profile of the user along with the access token
+func (r *Resolver) ExchangeOAuthCode(ctx context.Context, provider model.OAuthProvider, code string, state string) (profile *oauth.Profile, accessToken string, err error) {
+	storedState, err := r.OAuthStates.Take(ctx, state)
+	if err != nil {
+		return nil, "", err
+	}
+	if storedState.Provider != provider {
+		log.Printf("oauth state was created for %s but used with %s\n", storedState.Provider, provider)
+		return nil, "", oauth.StateNotValid
+	}
+
+	oAuthProvider, err := r.OAuthProviders.Get(provider)
+	if err != nil {
+		return nil, "", err
+	}
+	// Using the OAuth code provided exchange the code for an access token
+	token, err := oAuthProvider.Exchange(ctx, code, storedState)
+	if err != nil {
+		return nil, "", err
+	}
+	if !token.Valid() {
+		// this shouldn't happen unless there was man-in-the-middle tampering to the HTTP request involved
+		return nil, "", NewError(ErrorCodeUnauthenticated, "oauth token not valid")
+	}
+	profile, err = oAuthProvider.Profile(ctx, token.AccessToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return profile, token.AccessToken, nil
+}
+
+// SuggestedProfile is what a user who hasn't registered yet can prefill registration with
+func SuggestedProfile(provider model.OAuthProvider, profile *oauth.Profile) *model.SuggestedProfile {
+	suggested := &model.SuggestedProfile{
+		FirstName: emptyToNil(profile.FirstName),
+		LastName:  emptyToNil(profile.LastName),
+		Email:     emptyToNil(profile.Email),
+		AvatarURL: emptyToNil(profile.AvatarURL),
+	}
+	if provider == model.OAuthProviderGithub {
+		suggested.GithubLogin = emptyToNil(profile.Username)
+	}
+	return suggested
+}
+
+// PrefilledFields returns the fields of the new user that are the same as the provider's profile
+func PrefilledFields(input *model.NewUser, profile *oauth.Profile) []model.ProfileField {
+	fields := make([]model.ProfileField, 0, 3)
+	if profile.FirstName != "" && strings.TrimSpace(input.FirstName) == profile.FirstName {
+		fields = append(fields, model.ProfileFieldFirstName)
+	}
+	if profile.LastName != "" && strings.TrimSpace(input.LastName) == profile.LastName {
+		fields = append(fields, model.ProfileFieldLastName)
+	}
+	if profile.Email != "" && strings.EqualFold(strings.TrimSpace(input.Email), strings.TrimSpace(profile.Email)) {
+		fields = append(fields, model.ProfileFieldEmail)
+	}
+	return fields
+}
+
+func emptyToNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
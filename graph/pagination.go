@@ -0,0 +1,39 @@
+package graph
+
+import (
+	"fmt"
+	"github.com/KnightHacks/knighthacks_users/cursor"
+	"github.com/KnightHacks/knighthacks_users/repository"
+)
+
+// UsersMaxPageSize is the most users that can be asked for at once
+const UsersMaxPageSize = 20
+
+// NewPage validates the relay pagination arguments, either first or last must be given and
+// neither can be more than maxLength
+func NewPage(first *int, after *string, last *int, before *string, maxLength int) (repository.Page, error) {
+	if (first == nil) == (last == nil) {
+		return repository.Page{}, NewError(ErrorCodeValidationFailed, "either first or last must be given")
+	}
+	for _, length := range []*int{first, last} {
+		if length != nil && (*length < 0 || *length > maxLength) {
+			return repository.Page{}, NewError(ErrorCodeValidationFailed, fmt.Sprintf("at most %d entries can be asked for", maxLength))
+		}
+	}
+	page := repository.Page{First: first, Last: last}
+	var err error
+	if page.After, err = decodeCursor(after); err != nil {
+		return repository.Page{}, err
+	}
+	if page.Before, err = decodeCursor(before); err != nil {
+		return repository.Page{}, err
+	}
+	return page, nil
+}
+
+func decodeCursor(encoded *string) (*cursor.Cursor, error) {
+	if encoded == nil {
+		return nil, nil
+	}
+	return cursor.Decode(*encoded)
+}
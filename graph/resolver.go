@@ -2,7 +2,11 @@ package graph
 
 import (
 	"github.com/KnightHacks/knighthacks_shared/auth"
+	"github.com/KnightHacks/knighthacks_users/email"
+	"github.com/KnightHacks/knighthacks_users/oauth"
 	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/KnightHacks/knighthacks_users/validation"
+	"time"
 )
 
 // This file will not be regenerated automatically.
@@ -12,4 +16,19 @@ import (
 type Resolver struct {
 	Repository repository.Repository
 	Auth       *auth.Auth
+	// OAuthProviders are the providers users can login with
+	OAuthProviders oauth.Registry
+	// OAuthStates remembers each login between sending the user to their provider and them coming back
+	OAuthStates oauth.StateStore
+	// AllowedRedirectOrigins are the origins users can be redirected back to after logging in with their provider
+	AllowedRedirectOrigins []string
+	// RestoreWindow is how long a deleted user can be restored for before they are purged
+	RestoreWindow time.Duration
+	// ValidationRules are what new and updated users are validated against
+	ValidationRules validation.Rules
+	EmailSender     email.Sender
+	// EmailConfirmationURL is the page the link to confirm a new email opens, the token is added as a query parameter
+	EmailConfirmationURL string
+	// EmailVerificationURL is the page the link to verify an email opens, the token is added as a query parameter
+	EmailVerificationURL string
 }
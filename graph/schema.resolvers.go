@@ -2,19 +2,19 @@ package graph
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.31
 
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"math/rand"
-	"net/http"
-	"net/url"
+	"log"
+	"time"
 
 	"github.com/KnightHacks/knighthacks_shared/auth"
 	"github.com/KnightHacks/knighthacks_shared/models"
-	"github.com/KnightHacks/knighthacks_shared/pagination"
 	"github.com/KnightHacks/knighthacks_shared/utils"
 	"github.com/KnightHacks/knighthacks_users/graph/generated"
 	"github.com/KnightHacks/knighthacks_users/graph/model"
@@ -23,13 +23,23 @@ import (
 
 // User is the resolver for the user field.
 func (r *hackathonApplicationResolver) User(ctx context.Context, obj *model.HackathonApplication) (*model.User, error) {
-	return r.Repository.GetUserByID(ctx, obj.ID)
+	user, err := r.Loaders(ctx).ApplicationUsers.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, repository.UserNotFound
+	}
+	return user, nil
 }
 
 // Register is the resolver for the register field.
-func (r *mutationResolver) Register(ctx context.Context, provider models.Provider, encryptedOauthAccessToken string, input model.NewUser) (*model.RegistrationPayload, error) {
+func (r *mutationResolver) Register(ctx context.Context, provider model.OAuthProvider, encryptedOAuthAccessToken string, input model.NewUser) (*model.RegistrationPayload, error) {
+	if err := r.ValidationRules.ValidateNewUser(&input, time.Now()); err != nil {
+		return nil, err
+	}
 	// Decode the encrypted OAuth AccessToken from base64
-	b, err := base64.URLEncoding.DecodeString(encryptedOauthAccessToken)
+	b, err := base64.URLEncoding.DecodeString(encryptedOAuthAccessToken)
 	if err != nil {
 		return nil, err
 	}
@@ -38,19 +48,27 @@ func (r *mutationResolver) Register(ctx context.Context, provider models.Provide
 	if err != nil {
 		return nil, err
 	}
-	// Using the access token retrieve the OAuth provided UID of the user
-	uid, err := r.Auth.GetUID(ctx, provider, string(accessToken))
+	oAuthProvider, err := r.OAuthProviders.Get(provider)
+	if err != nil {
+		return nil, err
+	}
+	// Using the access token retrieve the OAuth provided profile of the user
+	profile, err := oAuthProvider.Profile(ctx, string(accessToken))
 	if err != nil {
 		return nil, err
 	}
 	// Create the user using the UID to check against duplicate accounts
-	user, err := r.Repository.CreateUser(ctx, &model.OAuth{UID: uid, Provider: provider}, &input)
+	user, err := r.Repository.CreateUser(ctx, &model.OAuth{UID: profile.UID, Provider: provider}, &input, PrefilledFields(&input, profile))
 	if err != nil {
 		// TODO: Possibly do some error handling hear to filter sql errors out
 		return nil, err
 	}
+	// the user has already been created, if this fails they can ask for another verification email
+	if err = r.VerifyRegistrationEmail(ctx, user, profile); err != nil {
+		log.Printf("unable to verify the email of user %s: %v\n", user.ID, err)
+	}
 
-	refresh, access, err := r.Auth.NewTokens(user.ID, user.Role)
+	refresh, access, err := r.NewTokens(ctx, user)
 	if err != nil {
 		return nil, err
 	}
@@ -62,117 +80,242 @@ func (r *mutationResolver) Register(ctx context.Context, provider models.Provide
 	return payload, nil
 }
 
+// Logout is the resolver for the logout field.
+func (r *mutationResolver) Logout(ctx context.Context, refreshToken string) (bool, error) {
+	if err := r.Repository.RevokeSession(ctx, refreshToken); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// LogoutAllSessions is the resolver for the logoutAllSessions field.
+func (r *mutationResolver) LogoutAllSessions(ctx context.Context) (bool, error) {
+	userClaims, err := auth.UserClaimsFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	if err = r.Repository.RevokeAllSessions(ctx, userClaims.UserID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // UpdateUser is the resolver for the updateUser field.
-func (r *mutationResolver) UpdateUser(ctx context.Context, id string, input model.UpdatedUser) (*model.User, error) {
-	if input.FirstName == nil && input.LastName == nil && input.Email == nil && input.PhoneNumber == nil && input.Pronouns == nil && input.Age == nil {
-		return nil, fmt.Errorf("no field has been updated")
+func (r *mutationResolver) UpdateUser(ctx context.Context, id string, input model.UpdatedUser, expectedVersion *int) (*model.User, error) {
+	if input.IsEmpty() {
+		return nil, NewError(ErrorCodeValidationFailed, "no field has been updated")
 	}
 
 	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
 	if !ok {
-		return nil, errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+		return nil, NotAuthenticated
 	}
 
 	if claims.Role != models.RoleAdmin && claims.UserID != id {
-		return nil, errors.New("unauthorized to update user that is not you")
+		return nil, NewError(ErrorCodeForbidden, "unauthorized to update user that is not you")
+	}
+	if err := r.ValidationRules.ValidateUpdatedUser(&input, time.Now()); err != nil {
+		return nil, err
 	}
 
-	return r.Repository.UpdateUser(ctx, id, &input)
-}
-
-// DeleteUser is the resolver for the deleteUser field.
-func (r *mutationResolver) DeleteUser(ctx context.Context, id string) (bool, error) {
-	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
-	if !ok {
-		return false, errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+	// the email is only changed once the new address is confirmed, the change is started in the
+	// same transaction as the update so it is rejected with it
+	user, emailChangeToken, err := r.Repository.UpdateUser(ctx, id, &input, expectedVersion, time.Now().Add(EmailChangeExpiry))
+	if err != nil {
+		return nil, err
 	}
-	if claims.Role != models.RoleAdmin && claims.UserID != id {
-		return false, errors.New("unauthorized to update user that is not you")
+	if emailChangeToken != "" {
+		if err = r.SendEmailChange(ctx, *input.Email, emailChangeToken); err != nil {
+			return nil, err
+		}
 	}
-	return r.Repository.DeleteUser(ctx, id)
+	return user, nil
 }
 
-// AddAPIKey is the resolver for the addAPIKey field.
-func (r *mutationResolver) AddAPIKey(ctx context.Context, userID string) (*model.APIKey, error) {
-	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
-	if !ok {
-		return nil, errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+// ConfirmEmailChange is the resolver for the confirmEmailChange field.
+func (r *mutationResolver) ConfirmEmailChange(ctx context.Context, token string) (*model.User, error) {
+	return r.Repository.ConfirmEmailChange(ctx, token)
+}
+
+// VerifyEmail is the resolver for the verifyEmail field.
+func (r *mutationResolver) VerifyEmail(ctx context.Context, token string) (*model.User, error) {
+	return r.Repository.VerifyEmail(ctx, token)
+}
+
+// ResendVerificationEmail is the resolver for the resendVerificationEmail field.
+func (r *mutationResolver) ResendVerificationEmail(ctx context.Context) (bool, error) {
+	userClaims, err := auth.UserClaimsFromContext(ctx)
+	if err != nil {
+		return false, err
 	}
-	if claims.Role != models.RoleAdmin && claims.UserID != userID {
-		return nil, errors.New("unauthorized to add an api key")
+	if err = r.SendEmailVerification(ctx, userClaims.UserID); err != nil {
+		return false, err
 	}
-	return r.Repository.AddAPIKey(ctx, userID, GenerateAPIKey(100))
+	return true, nil
 }
 
-// DeleteAPIKey is the resolver for the deleteAPIKey field.
-func (r *mutationResolver) DeleteAPIKey(ctx context.Context, userID string) (bool, error) {
+// DeleteUser is the resolver for the deleteUser field.
+func (r *mutationResolver) DeleteUser(ctx context.Context, id string, permanent *bool) (*model.DeletionReport, error) {
 	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
 	if !ok {
-		return false, errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+		return nil, NotAuthenticated
 	}
-	if claims.Role != models.RoleAdmin && claims.UserID != userID {
-		return false, errors.New("unauthorized to add an api key")
+	if claims.Role != models.RoleAdmin && claims.UserID != id {
+		return nil, NewError(ErrorCodeForbidden, "unauthorized to update user that is not you")
+	}
+	if permanent != nil && *permanent {
+		if claims.Role != models.RoleAdmin {
+			return nil, NewError(ErrorCodeForbidden, "only admins can permanently delete a user")
+		}
+		return r.Repository.DeleteUser(ctx, id)
 	}
-	err := r.Repository.DeleteAPIKey(ctx, userID)
+
+	deletedAt, err := r.Repository.SoftDeleteUser(ctx, id)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	return true, nil
+	restorableUntil := deletedAt.Add(r.RestoreWindow)
+	return &model.DeletionReport{
+		Deleted:         []*model.TableRowCount{},
+		Anonymized:      []*model.TableRowCount{},
+		RestorableUntil: &restorableUntil,
+	}, nil
 }
 
-// GetAuthRedirectLink is the resolver for the getAuthRedirectLink field.
-func (r *queryResolver) GetAuthRedirectLink(ctx context.Context, provider models.Provider, redirect *string) (string, error) {
-	ginContext, err := utils.GinContextFromContext(ctx)
+// RestoreUser is the resolver for the restoreUser field.
+func (r *mutationResolver) RestoreUser(ctx context.Context, id string) (*model.User, error) {
+	return r.Repository.RestoreUser(ctx, id, time.Now().UTC().Add(-r.RestoreWindow))
+}
+
+// ExportMyData is the resolver for the exportMyData field.
+func (r *mutationResolver) ExportMyData(ctx context.Context, id *string) (*model.DataExport, error) {
+	claims, err := auth.UserClaimsFromContext(ctx)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	userId := claims.UserID
+	if id != nil {
+		userId = *id
+	}
+	if claims.Role != models.RoleAdmin && claims.UserID != userId {
+		return nil, NewError(ErrorCodeForbidden, "unauthorized to export user that is not you")
 	}
 
-	b := make([]byte, 16)
-	_, err = rand.Read(b)
+	data, err := r.Repository.GetUserData(ctx, userId)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	state := base64.URLEncoding.EncodeToString(b)
-
-	// TODO: check into enabling secure behind proxy in production
-	ginContext.SetSameSite(http.SameSiteNoneMode)
-	ginContext.SetCookie("oauthstate", state, 60*10, "/", "", true, false)
-	ginContext.Header("Access-Control-Allow-Credentials", "true")
-	return r.Auth.GetAuthCodeURL(provider, state, redirect), nil
+	marshalled, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &model.DataExport{
+		GeneratedAt: time.Now().UTC(),
+		Data:        string(marshalled),
+	}, nil
 }
 
-// Login is the resolver for the login field.
-func (r *queryResolver) Login(ctx context.Context, provider models.Provider, code string, state string) (*model.LoginPayload, error) {
-	// todo: this should probably be cleaned up, been at this shit for hours, please god.. no more
-	ginContext, err := utils.GinContextFromContext(ctx)
+// LinkProvider is the resolver for the linkProvider field.
+func (r *mutationResolver) LinkProvider(ctx context.Context, provider model.OAuthProvider, code string, state string) (*model.User, error) {
+	claims, err := auth.UserClaimsFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	cookieHeader := ginContext.GetHeader("oauthstate")
-	cookieHeader, err = url.QueryUnescape(cookieHeader)
+	profile, _, err := r.ExchangeOAuthCode(ctx, provider, code, state)
 	if err != nil {
 		return nil, err
 	}
+	return r.Repository.LinkOAuthIdentity(ctx, claims.UserID, &model.OAuth{UID: profile.UID, Provider: provider})
+}
 
-	if cookieHeader != state {
-		return nil, fmt.Errorf("invalid oauth state, cookieHeader='%s', state='%s'", cookieHeader, state)
+// UnlinkProvider is the resolver for the unlinkProvider field.
+func (r *mutationResolver) UnlinkProvider(ctx context.Context, provider model.OAuthProvider) (*model.User, error) {
+	claims, err := auth.UserClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
+	return r.Repository.UnlinkOAuthIdentity(ctx, claims.UserID, provider)
+}
 
-	// Using the OAuth code provided exchange the code for an access token
-	token, err := r.Auth.ExchangeCode(ctx, provider, code)
+// SetUserRole is the resolver for the setUserRole field.
+func (r *mutationResolver) SetUserRole(ctx context.Context, id string, role models.Role) (*model.User, error) {
+	claims, err := auth.UserClaimsFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if !token.Valid() {
-		// this shouldn't happen unless there was man-in-the-middle tampering to the HTTP request involved
-		return nil, errors.New("auth token not valid, nice try hacker")
+	if role == models.RoleOwns || !role.IsValid() {
+		return nil, NewError(ErrorCodeValidationFailed, fmt.Sprintf("%s is not a role that can be given to a user", role))
+	}
+	return r.Repository.SetUserRole(ctx, id, role, claims.UserID)
+}
+
+// MergeUsers is the resolver for the mergeUsers field.
+func (r *mutationResolver) MergeUsers(ctx context.Context, keepID string, mergeID string) (*model.User, error) {
+	return r.Repository.MergeUsers(ctx, keepID, mergeID)
+}
+
+// AddAPIKey is the resolver for the addAPIKey field.
+func (r *mutationResolver) AddAPIKey(ctx context.Context, userID string, input model.NewAPIKey) (*model.NewAPIKeyPayload, error) {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return nil, NotAuthenticated
 	}
+	if claims.Role != models.RoleAdmin && claims.UserID != userID {
+		return nil, NewError(ErrorCodeForbidden, "unauthorized to add an api key")
+	}
+	if len(input.Scopes) == 0 {
+		return nil, NewError(ErrorCodeValidationFailed, "an api key must have at least one scope")
+	}
+	if input.ExpiresAt != nil && input.ExpiresAt.Before(time.Now()) {
+		return nil, NewError(ErrorCodeValidationFailed, "an api key cannot expire in the past")
+	}
+	return r.Repository.AddAPIKey(ctx, userID, &input)
+}
+
+// RevokeAPIKey is the resolver for the revokeAPIKey field.
+func (r *mutationResolver) RevokeAPIKey(ctx context.Context, userID string, id string) (bool, error) {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return false, NotAuthenticated
+	}
+	if claims.Role != models.RoleAdmin && claims.UserID != userID {
+		return false, NewError(ErrorCodeForbidden, "unauthorized to revoke an api key")
+	}
+	err := r.Repository.DeleteAPIKey(ctx, userID, id)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AddPronounOption is the resolver for the addPronounOption field.
+func (r *mutationResolver) AddPronounOption(ctx context.Context, input model.PronounsInput) (*model.PronounOption, error) {
+	return r.Repository.AddPronounOption(ctx, &input)
+}
+
+// RetirePronounOption is the resolver for the retirePronounOption field.
+func (r *mutationResolver) RetirePronounOption(ctx context.Context, id string) (*model.PronounOption, error) {
+	return r.Repository.RetirePronounOption(ctx, id)
+}
+
+// MergePronouns is the resolver for the mergePronouns field.
+func (r *mutationResolver) MergePronouns(ctx context.Context, keepID string, mergeID string) (*model.PronounOption, error) {
+	return r.Repository.MergePronouns(ctx, keepID, mergeID)
+}
+
+// GetAuthRedirectLink is the resolver for the getAuthRedirectLink field.
+func (r *queryResolver) GetAuthRedirectLink(ctx context.Context, provider model.OAuthProvider, redirect *string) (string, error) {
+	return r.StartOAuthLogin(ctx, provider, redirect)
+}
+
+// Login is the resolver for the login field.
+func (r *queryResolver) Login(ctx context.Context, provider model.OAuthProvider, code string, state string) (*model.LoginPayload, error) {
 	// Get the user by their OAuth ID, if the user == nil then the user hasn't created an account yet, but will using the Register function
-	uid, err := r.Auth.GetUID(ctx, provider, token.AccessToken)
+	profile, accessToken, err := r.ExchangeOAuthCode(ctx, provider, code, state)
 	if err != nil {
 		return nil, err
 	}
-	user, err := r.Repository.GetUserByOAuthUID(ctx, uid, provider)
+	user, err := r.Repository.GetUserByOAuthUID(ctx, profile.UID, provider)
 	if err != nil && !errors.Is(err, repository.UserNotFound) {
 		return nil, err
 	}
@@ -182,7 +325,7 @@ func (r *queryResolver) Login(ctx context.Context, provider models.Provider, cod
 		payload.User = user
 		payload.AccountExists = true
 
-		refresh, access, err := r.Auth.NewTokens(user.ID, user.Role)
+		refresh, access, err := r.NewTokens(ctx, user)
 		if err != nil {
 			return nil, err
 		}
@@ -190,12 +333,13 @@ func (r *queryResolver) Login(ctx context.Context, provider models.Provider, cod
 		payload.AccessToken = &access
 	} else {
 		// Using AES-256 encryption, encrypt the access token to protect against packet sniffing
-		encryptAccessTokenBytes := r.Auth.EncryptAccessToken(token.AccessToken)
+		encryptAccessTokenBytes := r.Auth.EncryptAccessToken(accessToken)
 
 		// Using base64 encoding, encode the access token to be able to be sent using alphanumeric character over HTTP
 		encodedAccessToken := base64.URLEncoding.EncodeToString(encryptAccessTokenBytes)
 
 		payload.EncryptedOAuthAccessToken = &encodedAccessToken
+		payload.SuggestedProfile = SuggestedProfile(provider, profile)
 	}
 
 	// The idea behind the last if statement is to return the user if it exists,
@@ -205,36 +349,34 @@ func (r *queryResolver) Login(ctx context.Context, provider models.Provider, cod
 }
 
 // RefreshJwt is the resolver for the refreshJWT field.
-func (r *queryResolver) RefreshJwt(ctx context.Context, refreshToken string) (string, error) {
-	refreshTokenUserClaims, err := r.Auth.ParseJWT(refreshToken, auth.RefreshTokenType)
+func (r *queryResolver) RefreshJwt(ctx context.Context, refreshToken string) (*model.RefreshPayload, error) {
+	// if the err is repository.RefreshTokenNotValid or repository.RefreshTokenReused then the user must login again
+	userId, newRefreshToken, err := r.Repository.RotateRefreshToken(ctx, refreshToken)
 	if err != nil {
-		// TODO: special handler for auth.TokenNotValid error
-		// if the err is auth.TokenNotValid then the user must login again
-		return "", err
+		return nil, err
 	}
-	token, err := r.Auth.NewAccessToken(refreshTokenUserClaims.UserID, refreshTokenUserClaims.Role)
+	// the role is always retrieved again in case an admin changed it since the session started
+	user, err := r.Repository.GetUserByID(ctx, userId)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return token, nil
-}
-
-// Users is the resolver for the users field.
-func (r *queryResolver) Users(ctx context.Context, first int, after *string) (*model.UsersConnection, error) {
-	a, err := pagination.DecodeCursor(after)
+	token, err := r.Auth.NewAccessToken(user.ID, user.Role)
 	if err != nil {
 		return nil, err
 	}
-	users, total, err := r.Repository.GetUsers(ctx, first, a)
+	return &model.RefreshPayload{
+		AccessToken:  token,
+		RefreshToken: newRefreshToken,
+	}, nil
+}
+
+// Users is the resolver for the users field.
+func (r *queryResolver) Users(ctx context.Context, first *int, after *string, last *int, before *string, filter *model.UserFilter, sort model.UserSort) (*model.UsersConnection, error) {
+	page, err := NewPage(first, after, last, before, UsersMaxPageSize)
 	if err != nil {
 		return nil, err
 	}
-
-	return &model.UsersConnection{
-		TotalCount: total,
-		PageInfo:   pagination.GetPageInfo(users[0].ID, users[len(users)-1].ID),
-		Users:      users,
-	}, nil
+	return r.Repository.GetUsers(ctx, filter, sort, page)
 }
 
 // GetUser is the resolver for the getUser field.
@@ -246,7 +388,7 @@ func (r *queryResolver) GetUser(ctx context.Context, id string) (*model.User, er
 func (r *queryResolver) SearchUser(ctx context.Context, name string) ([]*model.User, error) {
 	if !utils.IsASCII(name) {
 		// TODO: how to handle non ascii names? do they exist? idk
-		return nil, fmt.Errorf("the name must include only ascii characters")
+		return nil, NewError(ErrorCodeValidationFailed, "the name must include only ascii characters")
 	}
 
 	return r.Repository.SearchUser(ctx, name)
@@ -262,6 +404,61 @@ func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
 	return r.Entity().FindUserByID(ctx, userClaims.UserID)
 }
 
+// UserAsOf is the resolver for the userAsOf field.
+func (r *queryResolver) UserAsOf(ctx context.Context, id string, time time.Time) (*model.UserVersion, error) {
+	return r.Repository.GetUserVersionAsOf(ctx, id, time)
+}
+
+// DuplicateUsers is the resolver for the duplicateUsers field.
+func (r *queryResolver) DuplicateUsers(ctx context.Context, first int) ([]*model.DuplicateUsers, error) {
+	return r.Repository.GetDuplicateUsers(ctx, first)
+}
+
+// MySessions is the resolver for the mySessions field.
+func (r *queryResolver) MySessions(ctx context.Context) ([]*model.Session, error) {
+	userClaims, err := auth.UserClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.Repository.GetSessions(ctx, userClaims.UserID)
+}
+
+// AuditLog is the resolver for the auditLog field.
+func (r *queryResolver) AuditLog(ctx context.Context, userID string, first int, after *string) (*model.AuditLogConnection, error) {
+	a, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	return r.Repository.GetAuditLog(ctx, userID, first, a)
+}
+
+// PronounOptions is the resolver for the pronounOptions field.
+func (r *queryResolver) PronounOptions(ctx context.Context, includeRetired *bool) ([]*model.PronounOption, error) {
+	if includeRetired == nil || !*includeRetired {
+		return r.Repository.GetPronounOptions(ctx, false)
+	}
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return nil, NotAuthenticated
+	}
+	if claims.Role != models.RoleAdmin {
+		return nil, NewError(ErrorCodeForbidden, "only admins can see retired pronoun options")
+	}
+	return r.Repository.GetPronounOptions(ctx, true)
+}
+
+// ChangedBy is the resolver for the changedBy field.
+func (r *roleChangeResolver) ChangedBy(ctx context.Context, obj *model.RoleChange) (*model.User, error) {
+	if obj.ChangedBy == nil {
+		return nil, nil
+	}
+	user, err := r.Repository.GetUserByID(ctx, obj.ChangedBy.ID)
+	if errors.Is(err, repository.UserNotFound) {
+		return nil, nil
+	}
+	return user, err
+}
+
 // FullName is the resolver for the fullName field.
 func (r *userResolver) FullName(ctx context.Context, obj *model.User) (string, error) {
 	return fmt.Sprintf("%s %s", obj.FirstName, obj.LastName), nil
@@ -269,27 +466,61 @@ func (r *userResolver) FullName(ctx context.Context, obj *model.User) (string, e
 
 // OAuth is the resolver for the oAuth field.
 func (r *userResolver) OAuth(ctx context.Context, obj *model.User) (*model.OAuth, error) {
-	return r.Repository.GetOAuth(ctx, obj.ID)
+	oAuth, err := r.Loaders(ctx).OAuth.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if oAuth == nil {
+		return nil, repository.OAuthIdentityNotFound
+	}
+	return oAuth, nil
+}
+
+// Identities is the resolver for the identities field.
+func (r *userResolver) Identities(ctx context.Context, obj *model.User) ([]*model.OAuth, error) {
+	return r.Repository.GetOAuthIdentities(ctx, obj.ID)
 }
 
 // MailingAddress is the resolver for the mailingAddress field.
 func (r *userResolver) MailingAddress(ctx context.Context, obj *model.User) (*model.MailingAddress, error) {
-	return r.Repository.GetUserMailingAddress(ctx, obj.ID)
+	return r.Loaders(ctx).MailingAddresses.Load(ctx, obj.ID)
 }
 
 // Mlh is the resolver for the mlh field.
 func (r *userResolver) Mlh(ctx context.Context, obj *model.User) (*model.MLHTerms, error) {
-	return r.Repository.GetUserMLHTerms(ctx, obj.ID)
+	return r.Loaders(ctx).MLHTerms.Load(ctx, obj.ID)
 }
 
 // EducationInfo is the resolver for the educationInfo field.
 func (r *userResolver) EducationInfo(ctx context.Context, obj *model.User) (*model.EducationInfo, error) {
-	return r.Repository.GetUserEducationInfo(ctx, obj.ID)
+	return r.Loaders(ctx).EducationInfo.Load(ctx, obj.ID)
 }
 
-// APIKey is the resolver for the apiKey field.
-func (r *userResolver) APIKey(ctx context.Context, obj *model.User) (*model.APIKey, error) {
-	return r.Repository.GetAPIKey(ctx, obj.ID)
+// PendingEmail is the resolver for the pendingEmail field.
+func (r *userResolver) PendingEmail(ctx context.Context, obj *model.User) (*string, error) {
+	return r.Repository.GetPendingEmail(ctx, obj.ID)
+}
+
+// APIKeys is the resolver for the apiKeys field.
+func (r *userResolver) APIKeys(ctx context.Context, obj *model.User) ([]*model.APIKey, error) {
+	apiKeys, err := r.Loaders(ctx).APIKeys.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if apiKeys == nil {
+		return []*model.APIKey{}, nil
+	}
+	return apiKeys, nil
+}
+
+// RoleHistory is the resolver for the roleHistory field.
+func (r *userResolver) RoleHistory(ctx context.Context, obj *model.User) ([]*model.RoleChange, error) {
+	return r.Repository.GetRoleChanges(ctx, obj.ID)
+}
+
+// History is the resolver for the history field.
+func (r *userResolver) History(ctx context.Context, obj *model.User) ([]*model.UserVersion, error) {
+	return r.Repository.GetUserVersions(ctx, obj.ID)
 }
 
 // HackathonApplication returns generated.HackathonApplicationResolver implementation.
@@ -303,26 +534,14 @@ func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResol
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// RoleChange returns generated.RoleChangeResolver implementation.
+func (r *Resolver) RoleChange() generated.RoleChangeResolver { return &roleChangeResolver{r} }
+
 // User returns generated.UserResolver implementation.
 func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
 
 type hackathonApplicationResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type roleChangeResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
-
-// !!! WARNING !!!
-// The code below was going to be deleted when updating resolvers. It has been copied here so you have
-// one last chance to move it out of harms way if you want. There are two reasons this happens:
-//   - When renaming or deleting a resolver the old code will be put in here. You can safely delete
-//     it when you're done.
-//   - You have helper methods in this file. Move them out to keep these resolver files clean.
-var letterRunes = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
-
-func GenerateAPIKey(length int) string {
-	b := make([]rune, length)
-	for i := range b {
-		b[i] = letterRunes[rand.Intn(len(letterRunes))]
-	}
-	return string(b)
-}
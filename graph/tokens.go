@@ -0,0 +1,28 @@
+package graph
+
+import (
+	"context"
+	"github.com/KnightHacks/knighthacks_shared/utils"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+)
+
+// NewTokens starts a new session for the user and returns the session's refresh token
+// along with a new access token
+func (r *Resolver) NewTokens(ctx context.Context, user *model.User) (refresh string, access string, err error) {
+	var userAgent *string
+	if ginContext, err := utils.GinContextFromContext(ctx); err == nil {
+		if ua := ginContext.Request.UserAgent(); ua != "" {
+			userAgent = &ua
+		}
+	}
+
+	refresh, err = r.Repository.CreateSession(ctx, user.ID, userAgent)
+	if err != nil {
+		return "", "", err
+	}
+	access, err = r.Auth.NewAccessToken(user.ID, user.Role)
+	if err != nil {
+		return "", "", err
+	}
+	return refresh, access, nil
+}
@@ -2,18 +2,25 @@ package integration_tests
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	shared_db_utils "github.com/KnightHacks/knighthacks_shared/database"
 	"github.com/KnightHacks/knighthacks_shared/models"
 	"github.com/KnightHacks/knighthacks_shared/utils"
+	"github.com/KnightHacks/knighthacks_users/cursor"
 	model "github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/oauth"
+	"github.com/KnightHacks/knighthacks_users/repository"
 	"github.com/KnightHacks/knighthacks_users/repository/database"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"log"
 	"os"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -54,43 +61,135 @@ func TestMain(t *testing.M) {
 
 func TestDatabaseRepository_AddAPIKey(t *testing.T) {
 	type args struct {
-		ctx context.Context
-		id  string
-		key string
+		ctx    context.Context
+		userId string
+		input  *model.NewAPIKey
 	}
 	tests := []Test[args, *model.APIKey]{
 		{
 			name: "add APIKey to Joe Bob",
 			args: args{
-				ctx: context.Background(),
-				id:  "1",
-				key: "12345abcdef",
+				ctx:    context.Background(),
+				userId: "1",
+				input: &model.NewAPIKey{
+					Name:   "profile reader",
+					Scopes: []model.APIKeyScope{model.APIKeyScopeReadProfile},
+				},
 			},
 			want: &model.APIKey{
-				Key: "12345abcdef",
+				Name:   "profile reader",
+				Scopes: []model.APIKeyScope{model.APIKeyScopeReadProfile},
 			},
 			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			apiKey, err := databaseRepository.AddAPIKey(tt.args.ctx, tt.args.id, tt.args.key)
+			payload, err := databaseRepository.AddAPIKey(tt.args.ctx, tt.args.userId, tt.args.input)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("AddAPIKey() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(apiKey.Key, tt.want.Key) {
-				t.Errorf("AddAPIKey() apiKey = %v, want %v", apiKey, tt.want)
+			if len(payload.Key) == 0 {
+				t.Errorf("AddAPIKey() key is empty")
+			}
+			if payload.APIKey.Name != tt.want.Name || !reflect.DeepEqual(payload.APIKey.Scopes, tt.want.Scopes) {
+				t.Errorf("AddAPIKey() apiKey = %v, want %v", payload.APIKey, tt.want)
+			}
+			var keyHash string
+			err = databaseRepository.DatabasePool.QueryRow(tt.args.ctx, "SELECT key_hash FROM api_keys WHERE id = $1", payload.APIKey.ID).Scan(&keyHash)
+			if err != nil {
+				t.Errorf("unable to retrieve stored key hash err = %v", err)
+				return
+			}
+			if keyHash != database.HashAPIKey(payload.Key) {
+				t.Errorf("AddAPIKey() stored keyHash = %v, want hash of the returned key", keyHash)
+			}
+		})
+	}
+}
+
+func TestDatabaseRepository_GetUserByAPIKey(t *testing.T) {
+	payload, err := databaseRepository.AddAPIKey(context.Background(), "1", &model.NewAPIKey{
+		Name:   "check-in scanner",
+		Scopes: []model.APIKeyScope{model.APIKeyScopeCheckIn},
+	})
+	if err != nil {
+		t.Fatalf("unable to add api key err = %v", err)
+	}
+	expiredPayload, err := databaseRepository.AddAPIKey(context.Background(), "1", &model.NewAPIKey{
+		Name:      "expired",
+		Scopes:    []model.APIKeyScope{model.APIKeyScopeReadProfile},
+		ExpiresAt: utils.Ptr(time.Now().Add(-time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("unable to add api key err = %v", err)
+	}
+
+	type args struct {
+		ctx context.Context
+		key string
+	}
+	tests := []Test[args, string]{
+		{
+			name: "get Joe Bob by his APIKey",
+			args: args{
+				ctx: context.Background(),
+				key: payload.Key,
+			},
+			want:    "1",
+			wantErr: false,
+		},
+		{
+			name: "expired APIKey",
+			args: args{
+				ctx: context.Background(),
+				key: expiredPayload.Key,
+			},
+			wantErr: true,
+		},
+		{
+			name: "APIKey that doesn't exist",
+			args: args{
+				ctx: context.Background(),
+				key: "not a real key",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, apiKey, err := databaseRepository.GetUserByAPIKey(tt.args.ctx, tt.args.key)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetUserByAPIKey() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if user.ID != tt.want {
+				t.Errorf("GetUserByAPIKey() user = %v, want id %v", user, tt.want)
+			}
+			if apiKey.LastUsedAt == nil {
+				t.Errorf("GetUserByAPIKey() lastUsedAt was not set")
 			}
 		})
 	}
+
+	// clean up so the keys don't show up in TestDatabaseRepository_GetAPIKeys
+	for _, p := range []*model.NewAPIKeyPayload{payload, expiredPayload} {
+		if err = databaseRepository.DeleteAPIKey(context.Background(), "1", p.APIKey.ID); err != nil {
+			t.Errorf("unable to delete api key err = %v", err)
+		}
+	}
 }
 
 func TestDatabaseRepository_CreateUser(t *testing.T) {
 	type args struct {
-		ctx   context.Context
-		oAuth *model.OAuth
-		input *model.NewUser
+		ctx             context.Context
+		oAuth           *model.OAuth
+		input           *model.NewUser
+		prefilledFields []model.ProfileField
 	}
 	tests := []Test[args, *model.User]{
 		{
@@ -98,14 +197,14 @@ func TestDatabaseRepository_CreateUser(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				oAuth: &model.OAuth{
-					Provider: models.ProviderGithub,
+					Provider: model.OAuthProviderGithub,
 					UID:      "100",
 				},
 				input: &model.NewUser{
 					FirstName:   "Thomas",
 					LastName:    "Bob",
 					Email:       "thomas.bob@example.com",
-					PhoneNumber: "100-203-9112",
+					PhoneNumber: "407-203-9112",
 					Pronouns: &model.PronounsInput{
 						Subjective: "He",
 						Objective:  "Him",
@@ -136,16 +235,17 @@ func TestDatabaseRepository_CreateUser(t *testing.T) {
 					Gender: utils.Ptr("male"),
 					Race:   []model.Race{model.RaceCaucasian, model.RaceAfricanAmerican},
 				},
+				prefilledFields: []model.ProfileField{model.ProfileFieldFirstName, model.ProfileFieldEmail},
 			},
 			want: &model.User{
 				//ID:          "", don't check for this
 				FirstName:   "Thomas",
 				LastName:    "Bob",
 				Email:       "thomas.bob@example.com",
-				PhoneNumber: "100-203-9112",
+				PhoneNumber: "+14072039112",
 				Pronouns: &model.Pronouns{
-					Subjective: "He",
-					Objective:  "Him",
+					Subjective: "he",
+					Objective:  "him",
 				},
 				Age: utils.Ptr(21),
 				MailingAddress: &model.MailingAddress{
@@ -161,7 +261,7 @@ func TestDatabaseRepository_CreateUser(t *testing.T) {
 				Gender: utils.Ptr("male"),
 				Race:   []model.Race{model.RaceCaucasian, model.RaceAfricanAmerican},
 				OAuth: &model.OAuth{
-					Provider: models.ProviderGithub,
+					Provider: model.OAuthProviderGithub,
 					UID:      "100",
 				},
 				Mlh: &model.MLHTerms{
@@ -177,14 +277,16 @@ func TestDatabaseRepository_CreateUser(t *testing.T) {
 					Major:          "Bachelors of Science",
 					Level:          utils.Ptr(model.LevelOfStudyFreshman),
 				},
-				APIKey: nil,
+				Version:         1,
+				PrefilledFields: []model.ProfileField{model.ProfileFieldFirstName, model.ProfileFieldEmail},
+				APIKeys:         nil,
 			},
 			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			user, err := databaseRepository.CreateUser(tt.args.ctx, tt.args.oAuth, tt.args.input)
+			user, err := databaseRepository.CreateUser(tt.args.ctx, tt.args.oAuth, tt.args.input, tt.args.prefilledFields)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("CreateUser() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -192,29 +294,67 @@ func TestDatabaseRepository_CreateUser(t *testing.T) {
 			if !reflect.DeepEqual(user, tt.want) {
 				t.Errorf("CreateUser() user = %v, want %v", user, tt.want)
 			}
+			if user == nil {
+				return
+			}
+			// the prefilled fields are written with the user
+			stored, err := databaseRepository.GetUserByID(tt.args.ctx, user.ID)
+			if err != nil {
+				t.Fatalf("GetUserByID() error = %v", err)
+			}
+			if !reflect.DeepEqual(stored.PrefilledFields, tt.want.PrefilledFields) {
+				t.Errorf("GetUserByID() prefilled fields = %v, want %v", stored.PrefilledFields, tt.want.PrefilledFields)
+			}
 		})
 	}
 }
 
 func TestDatabaseRepository_DeleteAPIKey(t *testing.T) {
 	type args struct {
-		ctx context.Context
-		id  string
+		ctx    context.Context
+		userId string
+		id     string
 	}
-	tests := []Test[args, any]{
+	tests := []Test[args, error]{
+		{
+			name: "delete Joe Biron's APIKey as Joe Bob",
+			args: args{
+				ctx:    context.Background(),
+				userId: "1",
+				id:     "1",
+			},
+			want:    repository.APIKeyNotFound,
+			wantErr: true,
+		},
+		{
+			name: "delete an APIKey with an id that isn't a number",
+			args: args{
+				ctx:    context.Background(),
+				userId: "2",
+				id:     "abc",
+			},
+			want:    repository.APIKeyNotFound,
+			wantErr: true,
+		},
 		{
 			name: "delete Joe Biron's APIKey",
 			args: args{
-				ctx: context.Background(),
-				id:  "2",
+				ctx:    context.Background(),
+				userId: "2",
+				id:     "1",
 			},
 			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := databaseRepository.DeleteAPIKey(tt.args.ctx, tt.args.id); (err != nil) != tt.wantErr {
+			err := databaseRepository.DeleteAPIKey(tt.args.ctx, tt.args.userId, tt.args.id)
+			if (err != nil) != tt.wantErr {
 				t.Errorf("DeleteAPIKey() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !errors.Is(err, tt.want) {
+				t.Errorf("DeleteAPIKey() error = %v, want %v", err, tt.want)
 			}
 		})
 	}
@@ -225,7 +365,7 @@ func TestDatabaseRepository_DeleteUser(t *testing.T) {
 		ctx context.Context
 		id  string
 	}
-	tests := []Test[args, bool]{
+	tests := []Test[args, *model.DeletionReport]{
 		{
 			name: "delete Joe Biron",
 			args: args{
@@ -233,7 +373,13 @@ func TestDatabaseRepository_DeleteUser(t *testing.T) {
 				id:  "2",
 			},
 			wantErr: false,
-			want:    true,
+			want: &model.DeletionReport{
+				Deleted: []*model.TableRowCount{
+					{Table: "oauth_identities", Rows: 1},
+					{Table: "users", Rows: 1},
+				},
+				Anonymized: []*model.TableRowCount{},
+			},
 		},
 		{
 			name: "delete record that doesn't exist",
@@ -242,7 +388,16 @@ func TestDatabaseRepository_DeleteUser(t *testing.T) {
 				id:  "123343",
 			},
 			wantErr: true,
-			want:    false,
+			want:    nil,
+		},
+		{
+			name: "delete the last admin",
+			args: args{
+				ctx: context.Background(),
+				id:  "3",
+			},
+			wantErr: true,
+			want:    nil,
 		},
 	}
 	for _, tt := range tests {
@@ -252,40 +407,49 @@ func TestDatabaseRepository_DeleteUser(t *testing.T) {
 				t.Errorf("DeleteUser() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if got != tt.want {
+			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("DeleteUser() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestDatabaseRepository_GetAPIKey(t *testing.T) {
+func TestDatabaseRepository_GetAPIKeys(t *testing.T) {
 	type args struct {
 		ctx    context.Context
 		userId string
 	}
-	tests := []Test[args, *model.APIKey]{
+	tests := []Test[args, []*model.APIKey]{
 		{
-			name: "get Joe Bob's APIKey",
+			name: "get Joe Bob's APIKeys",
 			args: args{
 				ctx:    context.Background(),
 				userId: "1",
 			},
-			want: &model.APIKey{
-				Key: "12345abcdef",
+			want: []*model.APIKey{
+				{
+					Name:   "profile reader",
+					Scopes: []model.APIKeyScope{model.APIKeyScopeReadProfile},
+				},
 			},
 			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotApiKey, err := databaseRepository.GetAPIKey(tt.args.ctx, tt.args.userId)
+			gotApiKeys, err := databaseRepository.GetAPIKeys(tt.args.ctx, tt.args.userId)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GetAPIKey() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GetAPIKeys() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(gotApiKey.Key, tt.want.Key) {
-				t.Errorf("GetAPIKey() gotApiKey = %v, want %v", gotApiKey, tt.want)
+			if len(gotApiKeys) != len(tt.want) {
+				t.Errorf("GetAPIKeys() gotApiKeys = %v, want %v", gotApiKeys, tt.want)
+				return
+			}
+			for i, apiKey := range gotApiKeys {
+				if apiKey.Name != tt.want[i].Name || !reflect.DeepEqual(apiKey.Scopes, tt.want[i].Scopes) {
+					t.Errorf("GetAPIKeys() gotApiKey = %v, want %v", apiKey, tt.want[i])
+				}
 			}
 		})
 	}
@@ -315,7 +479,7 @@ func TestDatabaseRepository_GetById(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			pronouns, got1 := databaseRepository.GetById(tt.args.id)
+			pronouns, got1 := databaseRepository.Pronouns.GetByID(tt.args.id)
 			if !reflect.DeepEqual(pronouns, tt.want.pronouns) {
 				t.Errorf("GetById() pronouns = %v, want %v", pronouns, tt.want)
 			}
@@ -352,7 +516,7 @@ func TestDatabaseRepository_GetByPronouns(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			id, exists := databaseRepository.GetByPronouns(tt.args.pronouns)
+			id, exists := databaseRepository.Pronouns.GetByPronouns(tt.args.pronouns)
 			if id != tt.want.id {
 				t.Errorf("GetByPronouns() userId = %v, want %v", id, tt.want)
 			}
@@ -363,6 +527,47 @@ func TestDatabaseRepository_GetByPronouns(t *testing.T) {
 	}
 }
 
+func TestDatabaseRepository_GetOrCreatePronounRollback(t *testing.T) {
+	pronouns := model.Pronouns{Subjective: "rolled", Objective: "back"}
+	var pronounId *int
+	rollback := errors.New("rollback")
+	err := pgx.BeginTxFunc(context.Background(), databaseRepository.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var err error
+		pronounId, err = databaseRepository.GetOrCreatePronoun(context.Background(), tx, pronouns, nil)
+		if err != nil {
+			return err
+		}
+		// the pronouns can be read inside the transaction that created them
+		if _, err = databaseRepository.GetPronouns(context.Background(), tx, *pronounId); err != nil {
+			return err
+		}
+		return rollback
+	})
+	if !errors.Is(err, rollback) {
+		t.Fatalf("BeginTxFunc() error = %v, want %v", err, rollback)
+	}
+	if _, exists := databaseRepository.Pronouns.GetByPronouns(pronouns); exists {
+		t.Errorf("GetByPronouns() found pronouns that were rolled back")
+	}
+	if _, exists := databaseRepository.Pronouns.GetByID(*pronounId); exists {
+		t.Errorf("GetByID() found pronouns that were rolled back")
+	}
+}
+
+func TestDatabaseRepository_LoadPronouns(t *testing.T) {
+	// pronouns that aren't in the table are dropped when the store is refreshed
+	databaseRepository.Pronouns.Set(312345644, model.Pronouns{Subjective: "not", Objective: "stored"})
+	if err := databaseRepository.LoadPronouns(context.Background()); err != nil {
+		t.Fatalf("LoadPronouns() error = %v", err)
+	}
+	if _, exists := databaseRepository.Pronouns.GetByID(312345644); exists {
+		t.Errorf("GetByID() found pronouns that aren't in the pronouns table")
+	}
+	if _, exists := databaseRepository.Pronouns.GetByID(1); !exists {
+		t.Errorf("GetByID() didn't find pronouns that are in the pronouns table")
+	}
+}
+
 func TestDatabaseRepository_GetOAuth(t *testing.T) {
 	type args struct {
 		ctx    context.Context
@@ -376,7 +581,7 @@ func TestDatabaseRepository_GetOAuth(t *testing.T) {
 				userId: "1",
 			},
 			want: &model.OAuth{
-				Provider: models.ProviderGithub,
+				Provider: model.OAuthProviderGithub,
 				UID:      "1",
 			},
 			wantErr: false,
@@ -511,6 +716,115 @@ func TestDatabaseRepository_GetOrCreatePronoun(t *testing.T) {
 	}
 }
 
+func TestDatabaseRepository_GetUserData(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		userId string
+	}
+	tests := []Test[args, *model.UserData]{
+		{
+			name: "export Joe Bob",
+			args: args{
+				ctx:    context.Background(),
+				userId: "1",
+			},
+			want: &model.UserData{
+				OAuthIdentities: []*model.OAuth{
+					{Provider: model.OAuthProviderGithub, UID: "1"},
+				},
+				MailingAddress: &model.MailingAddress{
+					Country:      "United States",
+					State:        "Florida",
+					City:         "Orlando",
+					PostalCode:   "32765",
+					AddressLines: []string{"1000 Abc Rd", "APT 69"},
+				},
+				MLHTerms: &model.MLHTerms{
+					SendMessages:  true,
+					CodeOfConduct: true,
+					ShareInfo:     true,
+				},
+				EducationInfo: nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "user that doesn't exist",
+			args: args{
+				ctx:    context.Background(),
+				userId: "123343",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := databaseRepository.GetUserData(tt.args.ctx, tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetUserData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.User.ID != tt.args.userId {
+				t.Errorf("GetUserData() user = %v, want id %s", got.User, tt.args.userId)
+			}
+			if !reflect.DeepEqual(got.OAuthIdentities, tt.want.OAuthIdentities) {
+				t.Errorf("GetUserData() oAuthIdentities = %v, want %v", got.OAuthIdentities, tt.want.OAuthIdentities)
+			}
+			if !reflect.DeepEqual(got.MailingAddress, tt.want.MailingAddress) {
+				t.Errorf("GetUserData() mailingAddress = %v, want %v", got.MailingAddress, tt.want.MailingAddress)
+			}
+			if !reflect.DeepEqual(got.MLHTerms, tt.want.MLHTerms) {
+				t.Errorf("GetUserData() mlhTerms = %v, want %v", got.MLHTerms, tt.want.MLHTerms)
+			}
+			if !reflect.DeepEqual(got.EducationInfo, tt.want.EducationInfo) {
+				t.Errorf("GetUserData() educationInfo = %v, want %v", got.EducationInfo, tt.want.EducationInfo)
+			}
+		})
+	}
+
+	// the user's audit log is exported oldest first, redacted the same as the auditLog query
+	user, err := databaseRepository.CreateUser(context.Background(), &model.OAuth{
+		Provider: model.OAuthProviderGithub,
+		UID:      "export-audit-log",
+	}, &model.NewUser{
+		FirstName:   "Export",
+		LastName:    "Audit",
+		Email:       "export.audit@example.com",
+		PhoneNumber: "407-555-0140",
+		ShirtSize:   utils.Ptr(model.ShirtSizeM),
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to create user err = %v", err)
+	}
+	if _, _, err = databaseRepository.UpdateUser(context.Background(), user.ID, &model.UpdatedUser{FirstName: utils.Ptr("Exported")}, nil, time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+	data, err := databaseRepository.GetUserData(context.Background(), user.ID)
+	if err != nil {
+		t.Fatalf("GetUserData() error = %v", err)
+	}
+	if len(data.AuditLog) != 2 || data.AuditLog[0].Operation != model.AuditOperationCreateUser || data.AuditLog[1].Operation != model.AuditOperationUpdateUser {
+		t.Fatalf("GetUserData() auditLog = %v, want a CREATE_USER and an UPDATE_USER entry", data.AuditLog)
+	}
+	wantChanges := []*model.FieldChange{
+		{Field: "firstName", Before: utils.Ptr(database.Redacted), After: utils.Ptr(database.Redacted)},
+	}
+	if !reflect.DeepEqual(data.AuditLog[1].Changes, wantChanges) {
+		t.Errorf("GetUserData() auditLog changes = %v, want %v", data.AuditLog[1].Changes, wantChanges)
+	}
+	auditLog, err := databaseRepository.GetAuditLog(context.Background(), user.ID, 10, nil)
+	if err != nil {
+		t.Fatalf("GetAuditLog() error = %v", err)
+	}
+	entries := auditLog.Entries
+	if len(entries) != 2 || !reflect.DeepEqual(data.AuditLog[0], entries[1]) || !reflect.DeepEqual(data.AuditLog[1], entries[0]) {
+		t.Errorf("GetUserData() auditLog = %v, want %v oldest first", data.AuditLog, entries)
+	}
+}
+
 func TestDatabaseRepository_GetUserByID(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -543,7 +857,9 @@ func TestDatabaseRepository_GetUserByID(t *testing.T) {
 				ShirtSize:         utils.Ptr(model.ShirtSizeL),
 				YearsOfExperience: utils.Ptr(3.5),
 				EducationInfo:     nil,
-				APIKey:            nil,
+				Version:           1,
+				PrefilledFields:   []model.ProfileField{},
+				APIKeys:           nil,
 			},
 			wantErr: false,
 		},
@@ -576,7 +892,7 @@ func TestDatabaseRepository_GetUserByOAuthUID(t *testing.T) {
 	type args struct {
 		ctx      context.Context
 		oAuthUID string
-		provider models.Provider
+		provider model.OAuthProvider
 	}
 	tests := []Test[args, *model.User]{
 		{
@@ -584,7 +900,7 @@ func TestDatabaseRepository_GetUserByOAuthUID(t *testing.T) {
 			args: args{
 				ctx:      context.Background(),
 				oAuthUID: "1",
-				provider: models.ProviderGithub,
+				provider: model.OAuthProviderGithub,
 			},
 			want: &model.User{
 				ID:          "1",
@@ -606,7 +922,9 @@ func TestDatabaseRepository_GetUserByOAuthUID(t *testing.T) {
 				ShirtSize:         utils.Ptr(model.ShirtSizeL),
 				YearsOfExperience: utils.Ptr(3.5),
 				EducationInfo:     nil,
-				APIKey:            nil,
+				Version:           1,
+				PrefilledFields:   []model.ProfileField{},
+				APIKeys:           nil,
 			},
 			wantErr: false,
 		},
@@ -700,34 +1018,219 @@ func TestDatabaseRepository_GetUserMailingAddress(t *testing.T) {
 }
 
 func TestDatabaseRepository_GetUsers(t *testing.T) {
-	type args struct {
-		ctx   context.Context
-		first int
-		after string
+	// walks every user forwards then backwards two at a time
+	var forwards []string
+	page := repository.Page{First: utils.Ptr(2)}
+	for {
+		connection, err := databaseRepository.GetUsers(context.Background(), nil, model.UserSortID, page)
+		if err != nil {
+			t.Fatalf("GetUsers() error = %v", err)
+		}
+		if connection.PageInfo.HasPreviousPage != (page.After != nil) {
+			t.Errorf("GetUsers() hasPreviousPage = %v after %v", connection.PageInfo.HasPreviousPage, page.After)
+		}
+		for _, user := range connection.Users {
+			forwards = append(forwards, user.ID)
+		}
+		if !connection.PageInfo.HasNextPage {
+			if len(forwards) != connection.TotalCount {
+				t.Errorf("GetUsers() returned %d users, want totalCount %d", len(forwards), connection.TotalCount)
+			}
+			break
+		}
+		if page.After, err = cursor.Decode(connection.PageInfo.EndCursor); err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+	}
+	for i := 1; i < len(forwards); i++ {
+		previous, _ := strconv.Atoi(forwards[i-1])
+		current, _ := strconv.Atoi(forwards[i])
+		if previous >= current {
+			t.Fatalf("GetUsers() users are not ordered by id, %v", forwards)
+		}
+	}
+
+	var backwards []string
+	page = repository.Page{Last: utils.Ptr(2)}
+	for {
+		connection, err := databaseRepository.GetUsers(context.Background(), nil, model.UserSortID, page)
+		if err != nil {
+			t.Fatalf("GetUsers() error = %v", err)
+		}
+		if connection.PageInfo.HasNextPage != (page.Before != nil) {
+			t.Errorf("GetUsers() hasNextPage = %v before %v", connection.PageInfo.HasNextPage, page.Before)
+		}
+		ids := make([]string, 0, len(connection.Users))
+		for _, user := range connection.Users {
+			ids = append(ids, user.ID)
+		}
+		backwards = append(ids, backwards...)
+		if !connection.PageInfo.HasPreviousPage {
+			break
+		}
+		if page.Before, err = cursor.Decode(connection.PageInfo.StartCursor); err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+	}
+	if !reflect.DeepEqual(forwards, backwards) {
+		t.Errorf("GetUsers() backwards = %v, want %v", backwards, forwards)
+	}
+
+	empty, err := databaseRepository.GetUsers(context.Background(), nil, model.UserSortID, repository.Page{First: utils.Ptr(0)})
+	if err != nil {
+		t.Fatalf("GetUsers() error = %v", err)
 	}
-	type want struct {
-		users []*model.User
-		total int
+	if len(empty.Users) != 0 || !empty.PageInfo.HasNextPage || empty.PageInfo.StartCursor != "" {
+		t.Errorf("GetUsers() got = %v, want an empty page", empty)
 	}
-	tests := []Test[args, want]{
 
-		// TODO: Add test cases.
+	wrongSort := cursor.Cursor{Sort: "LAST_NAME", Key: "Bob", ID: "1"}
+	if _, err = databaseRepository.GetUsers(context.Background(), nil, model.UserSortID, repository.Page{First: utils.Ptr(2), After: &wrongSort}); !errors.Is(err, cursor.NotValid) {
+		t.Errorf("GetUsers() error = %v, want %v", err, cursor.NotValid)
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			users, total, err := databaseRepository.GetUsers(tt.args.ctx, tt.args.first, tt.args.after)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("GetUsers() error = %v, wantErr %v", err, tt.wantErr)
-				return
+}
+
+func TestDatabaseRepository_GetUsersNewest(t *testing.T) {
+	// newer registered first but is given a later time, unrecorded registered before times were recorded
+	ids := make(map[string]string)
+	for i, name := range []string{"newer", "older", "unrecorded"} {
+		user, err := databaseRepository.CreateUser(context.Background(), &model.OAuth{
+			Provider: model.OAuthProviderGithub,
+			UID:      "newest-" + name,
+		}, &model.NewUser{
+			FirstName:   name,
+			LastName:    "Newest",
+			Email:       name + ".newest@example.com",
+			PhoneNumber: fmt.Sprintf("407-555-016%d", i),
+			ShirtSize:   utils.Ptr(model.ShirtSizeM),
+		}, nil)
+		if err != nil {
+			t.Fatalf("unable to create user err = %v", err)
+		}
+		ids[name] = user.ID
+	}
+	_, err := databaseRepository.DatabasePool.Exec(context.Background(), "UPDATE users SET created = created - interval '1 day' WHERE id = $1", ids["older"])
+	if err != nil {
+		t.Fatalf("unable to set created err = %v", err)
+	}
+	if _, err = databaseRepository.DatabasePool.Exec(context.Background(), "UPDATE users SET created = NULL WHERE id = $1", ids["unrecorded"]); err != nil {
+		t.Fatalf("unable to set created err = %v", err)
+	}
+
+	positions := make(map[string]int)
+	page := repository.Page{First: utils.Ptr(2)}
+	for {
+		connection, err := databaseRepository.GetUsers(context.Background(), nil, model.UserSortNewest, page)
+		if err != nil {
+			t.Fatalf("GetUsers() error = %v", err)
+		}
+		for _, user := range connection.Users {
+			if _, seen := positions[user.ID]; seen {
+				t.Fatalf("GetUsers() returned user %s twice", user.ID)
 			}
-			if !reflect.DeepEqual(users, tt.want) {
-				t.Errorf("GetUsers() users = %v, want %v", users, tt.want)
+			positions[user.ID] = len(positions)
+		}
+		if !connection.PageInfo.HasNextPage {
+			if len(positions) != connection.TotalCount {
+				t.Errorf("GetUsers() returned %d users, want totalCount %d", len(positions), connection.TotalCount)
+			}
+			break
+		}
+		if page.After, err = cursor.Decode(connection.PageInfo.EndCursor); err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+	}
+	if positions[ids["newer"]] > positions[ids["older"]] {
+		t.Errorf("GetUsers() returned user %s before %s, want the one created last first", ids["older"], ids["newer"])
+	}
+	if positions[ids["unrecorded"]] < positions[ids["older"]] {
+		t.Errorf("GetUsers() returned user %s before %s, want users without a created time last", ids["unrecorded"], ids["older"])
+	}
+}
+
+func TestDatabaseRepository_GetUsersFilterAndSort(t *testing.T) {
+	filter := &model.UserFilter{MinAge: utils.Ptr(0), RegisteredBefore: utils.Ptr(time.Now().Add(time.Hour))}
+	for _, sort := range model.AllUserSort {
+		t.Run(sort.String(), func(t *testing.T) {
+			// each page is checked to follow on from the last one
+			seen := map[string]bool{}
+			page := repository.Page{First: utils.Ptr(1)}
+			var total int
+			for {
+				connection, err := databaseRepository.GetUsers(context.Background(), filter, sort, page)
+				if err != nil {
+					t.Fatalf("GetUsers() error = %v", err)
+				}
+				total = connection.TotalCount
+				for _, user := range connection.Users {
+					if seen[user.ID] {
+						t.Fatalf("GetUsers() returned user %s twice", user.ID)
+					}
+					if user.Age == nil || *user.Age < 0 {
+						t.Errorf("GetUsers() returned user %s that doesn't match the filter", user.ID)
+					}
+					seen[user.ID] = true
+				}
+				if !connection.PageInfo.HasNextPage {
+					break
+				}
+				if page.After, err = cursor.Decode(connection.PageInfo.EndCursor); err != nil {
+					t.Fatalf("Decode() error = %v", err)
+				}
 			}
-			if total != tt.want.total {
-				t.Errorf("GetUsers() total = %v, want %v", total, tt.want.total)
+			if len(seen) != total {
+				t.Errorf("GetUsers() returned %d users, want totalCount %d", len(seen), total)
 			}
 		})
 	}
+
+	none, err := databaseRepository.GetUsers(context.Background(), &model.UserFilter{MinAge: utils.Ptr(200)}, model.UserSortID, repository.Page{First: utils.Ptr(20)})
+	if err != nil {
+		t.Fatalf("GetUsers() error = %v", err)
+	}
+	if none.TotalCount != 0 || len(none.Users) != 0 {
+		t.Errorf("GetUsers() got = %v, want no users", none)
+	}
+
+	expired, err := databaseRepository.CreateUser(context.Background(), &model.OAuth{
+		Provider: model.OAuthProviderGithub,
+		UID:      "expired-api-key",
+	}, &model.NewUser{
+		FirstName:   "Ex",
+		LastName:    "Pired",
+		Email:       "ex.pired@example.com",
+		PhoneNumber: "407-200-3021",
+		ShirtSize:   utils.Ptr(model.ShirtSizeM),
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to create user err = %v", err)
+	}
+	if _, err = databaseRepository.AddAPIKey(context.Background(), expired.ID, &model.NewAPIKey{
+		Name:   "expired key",
+		Scopes: []model.APIKeyScope{model.APIKeyScopeReadProfile},
+	}); err != nil {
+		t.Fatalf("unable to add api key err = %v", err)
+	}
+	if _, err = databaseRepository.DatabasePool.Exec(context.Background(), "UPDATE api_keys SET expires_at = $1 WHERE user_id = $2", time.Now().UTC().Add(-time.Hour), expired.ID); err != nil {
+		t.Fatalf("unable to expire api key err = %v", err)
+	}
+
+	withKeys, err := databaseRepository.GetUsers(context.Background(), &model.UserFilter{HasAPIKey: utils.Ptr(true)}, model.UserSortLastName, repository.Page{First: utils.Ptr(20)})
+	if err != nil {
+		t.Fatalf("GetUsers() error = %v", err)
+	}
+	for _, user := range withKeys.Users {
+		if user.ID == expired.ID {
+			t.Errorf("GetUsers() returned user %s whose only api key expired", user.ID)
+		}
+		keys, err := databaseRepository.GetAPIKeys(context.Background(), user.ID)
+		if err != nil {
+			t.Fatalf("GetAPIKeys() error = %v", err)
+		}
+		if len(keys) == 0 {
+			t.Errorf("GetUsers() returned user %s without an api key", user.ID)
+		}
+	}
 }
 
 func TestDatabaseRepository_InsertEducationInfo(t *testing.T) {
@@ -793,11 +1296,11 @@ func TestDatabaseRepository_InsertMailingAddress(t *testing.T) {
 
 func TestDatabaseRepository_InsertUser(t *testing.T) {
 	type args struct {
-		ctx          context.Context
-		queryable    shared_db_utils.Queryable
-		input        *model.NewUser
-		pronounIdPtr *int
-		oAuth        *model.OAuth
+		ctx             context.Context
+		queryable       shared_db_utils.Queryable
+		input           *model.NewUser
+		pronounIdPtr    *int
+		prefilledFields []model.ProfileField
 	}
 	tests := []Test[args, any]{
 
@@ -805,7 +1308,7 @@ func TestDatabaseRepository_InsertUser(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := databaseRepository.InsertUser(tt.args.ctx, tt.args.queryable, tt.args.input, tt.args.pronounIdPtr, tt.args.oAuth)
+			got, err := databaseRepository.InsertUser(tt.args.ctx, tt.args.queryable, tt.args.input, tt.args.pronounIdPtr, tt.args.prefilledFields)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("InsertUser() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -817,21 +1320,443 @@ func TestDatabaseRepository_InsertUser(t *testing.T) {
 	}
 }
 
-func TestDatabaseRepository_SearchUser(t *testing.T) {
+func TestDatabaseRepository_LinkOAuthIdentity(t *testing.T) {
 	type args struct {
-		ctx  context.Context
-		name string
+		ctx    context.Context
+		userId string
+		oAuth  *model.OAuth
 	}
-	tests := []Test[args, []*model.User]{
-
-		// TODO: Add test cases.
+	tests := []Test[args, any]{
+		{
+			name: "link gmail to Joe Bob",
+			args: args{
+				ctx:    context.Background(),
+				userId: "1",
+				oAuth: &model.OAuth{
+					Provider: model.OAuthProviderGmail,
+					UID:      "joe.bob.gmail",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "link the same gmail to Joe Bob again",
+			args: args{
+				ctx:    context.Background(),
+				userId: "1",
+				oAuth: &model.OAuth{
+					Provider: model.OAuthProviderGmail,
+					UID:      "joe.bob.gmail",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "link a second gmail to Joe Bob",
+			args: args{
+				ctx:    context.Background(),
+				userId: "1",
+				oAuth: &model.OAuth{
+					Provider: model.OAuthProviderGmail,
+					UID:      "joe.bob.other.gmail",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "link dough boy's github to Joe Bob",
+			args: args{
+				ctx:    context.Background(),
+				userId: "1",
+				oAuth: &model.OAuth{
+					Provider: model.OAuthProviderGithub,
+					UID:      "12velofabo12",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "user that doesn't exist",
+			args: args{
+				ctx:    context.Background(),
+				userId: "123343",
+				oAuth: &model.OAuth{
+					Provider: model.OAuthProviderGmail,
+					UID:      "nobody.gmail",
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			users, err := databaseRepository.SearchUser(tt.args.ctx, tt.args.name)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("SearchUser() error = %v, wantErr %v", err, tt.wantErr)
-				return
+			if _, err := databaseRepository.LinkOAuthIdentity(tt.args.ctx, tt.args.userId, tt.args.oAuth); (err != nil) != tt.wantErr {
+				t.Errorf("LinkOAuthIdentity() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	user, err := databaseRepository.GetUserByOAuthUID(context.Background(), "joe.bob.gmail", model.OAuthProviderGmail)
+	if err != nil {
+		t.Fatalf("GetUserByOAuthUID() error = %v", err)
+	}
+	if user.ID != "1" {
+		t.Errorf("GetUserByOAuthUID() got user %s, want 1", user.ID)
+	}
+
+	identities, err := databaseRepository.GetOAuthIdentities(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetOAuthIdentities() error = %v", err)
+	}
+	want := []*model.OAuth{
+		{Provider: model.OAuthProviderGithub, UID: "1"},
+		{Provider: model.OAuthProviderGmail, UID: "joe.bob.gmail"},
+	}
+	if !reflect.DeepEqual(identities, want) {
+		t.Errorf("GetOAuthIdentities() got = %v, want %v", identities, want)
+	}
+}
+
+func TestDatabaseRepository_UnlinkOAuthIdentity(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		userId   string
+		provider model.OAuthProvider
+	}
+	tests := []Test[args, error]{
+		{
+			name: "unlink Joe Bob's gmail",
+			args: args{
+				ctx:      context.Background(),
+				userId:   "1",
+				provider: model.OAuthProviderGmail,
+			},
+			wantErr: false,
+		},
+		{
+			name: "unlink Joe Bob's gmail again",
+			args: args{
+				ctx:      context.Background(),
+				userId:   "1",
+				provider: model.OAuthProviderGmail,
+			},
+			want:    repository.OAuthIdentityNotFound,
+			wantErr: true,
+		},
+		{
+			name: "unlink Joe Bob's last identity",
+			args: args{
+				ctx:      context.Background(),
+				userId:   "1",
+				provider: model.OAuthProviderGithub,
+			},
+			want:    repository.LastOAuthIdentity,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := databaseRepository.UnlinkOAuthIdentity(tt.args.ctx, tt.args.userId, tt.args.provider)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnlinkOAuthIdentity() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !errors.Is(err, tt.want) {
+				t.Errorf("UnlinkOAuthIdentity() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if _, err := databaseRepository.GetUserByOAuthUID(context.Background(), "joe.bob.gmail", model.OAuthProviderGmail); !errors.Is(err, repository.UserNotFound) {
+		t.Errorf("GetUserByOAuthUID() error = %v, want %v", err, repository.UserNotFound)
+	}
+}
+
+func TestDatabaseRepository_MergeUsers(t *testing.T) {
+	keep, err := databaseRepository.CreateUser(context.Background(), &model.OAuth{
+		Provider: model.OAuthProviderGithub,
+		UID:      "grace-github",
+	}, &model.NewUser{
+		FirstName:   "Grace",
+		LastName:    "Hopper",
+		Email:       "grace.hopper@example.com",
+		PhoneNumber: "407-555-0100",
+		ShirtSize:   utils.Ptr(model.ShirtSizeM),
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to create user err = %v", err)
+	}
+	merge, err := databaseRepository.CreateUser(context.Background(), &model.OAuth{
+		Provider: model.OAuthProviderGmail,
+		UID:      "grace-gmail",
+	}, &model.NewUser{
+		FirstName:   "Grace",
+		LastName:    "Hoper",
+		Email:       "Grace.Hopper+hackathon@example.com",
+		PhoneNumber: "(407) 555-0101",
+		ShirtSize:   utils.Ptr(model.ShirtSizeM),
+		Mlh: &model.MLHTermsInput{
+			SendMessages:  true,
+			CodeOfConduct: true,
+			ShareInfo:     false,
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to create user err = %v", err)
+	}
+	// the number is stored as it was typed before phone numbers were normalized, a normalized
+	// number can't be used by two users
+	if _, err = databaseRepository.DatabasePool.Exec(context.Background(), "UPDATE users SET phone_number = $1 WHERE id = $2", "(407) 555-0100", merge.ID); err != nil {
+		t.Fatalf("unable to update phone number err = %v", err)
+	}
+	if _, err = databaseRepository.AddAPIKey(context.Background(), merge.ID, &model.NewAPIKey{
+		Name:   "merged key",
+		Scopes: []model.APIKeyScope{model.APIKeyScopeReadProfile, model.APIKeyScopeAdminExport},
+	}); err != nil {
+		t.Fatalf("unable to add api key err = %v", err)
+	}
+	if _, err = databaseRepository.AddAPIKey(context.Background(), merge.ID, &model.NewAPIKey{
+		Name:   "merged export key",
+		Scopes: []model.APIKeyScope{model.APIKeyScopeAdminExport},
+	}); err != nil {
+		t.Fatalf("unable to add api key err = %v", err)
+	}
+
+	// both users applied to the same hackathon
+	var hackathonId int
+	err = databaseRepository.DatabasePool.QueryRow(context.Background(), `WITH term AS (INSERT INTO terms (year, semester) VALUES (2031, 'FALL') RETURNING id)
+		INSERT INTO hackathons (term_id, start_date, end_date) SELECT id, '2031-10-01', '2031-10-03' FROM term RETURNING id`).Scan(&hackathonId)
+	if err != nil {
+		t.Fatalf("unable to create hackathon err = %v", err)
+	}
+	for _, userId := range []string{keep.ID, merge.ID} {
+		_, err = databaseRepository.DatabasePool.Exec(context.Background(), `INSERT INTO hackathon_applications (user_id, hackathon_id, why_attend, what_do_you_want_to_learn, share_info_with_sponsors, application_status)
+			VALUES ($1, $2, ARRAY ['fun'], ARRAY ['go'], false, 'WAITING')`, userId, hackathonId)
+		if err != nil {
+			t.Fatalf("unable to create application err = %v", err)
+		}
+	}
+	_, err = databaseRepository.MergeUsers(context.Background(), keep.ID, merge.ID)
+	var conflict *repository.MergeConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("MergeUsers() error = %v, want a MergeConflictError", err)
+	}
+	wantConflicts := []repository.MergeConflict{{Table: "hackathon_applications", Key: strconv.Itoa(hackathonId)}}
+	if !reflect.DeepEqual(conflict.Conflicts, wantConflicts) {
+		t.Errorf("MergeUsers() conflicts = %v, want %v", conflict.Conflicts, wantConflicts)
+	}
+	// the admin decides to keep the merged user's application
+	if _, err = databaseRepository.DatabasePool.Exec(context.Background(), "DELETE FROM hackathon_applications WHERE user_id = $1", keep.ID); err != nil {
+		t.Fatalf("unable to delete application err = %v", err)
+	}
+
+	duplicates, err := databaseRepository.GetDuplicateUsers(context.Background(), 50)
+	if err != nil {
+		t.Fatalf("GetDuplicateUsers() error = %v", err)
+	}
+	var found *model.DuplicateUsers
+	for _, duplicate := range duplicates {
+		if duplicate.User.ID == keep.ID && duplicate.Duplicate.ID == merge.ID {
+			found = duplicate
+		}
+	}
+	if found == nil {
+		t.Fatalf("GetDuplicateUsers() did not find %s and %s", keep.ID, merge.ID)
+	}
+	wantReasons := []model.DuplicateReason{model.DuplicateReasonEmail, model.DuplicateReasonPhoneNumber, model.DuplicateReasonName}
+	if !reflect.DeepEqual(found.Reasons, wantReasons) {
+		t.Errorf("GetDuplicateUsers() reasons = %v, want %v", found.Reasons, wantReasons)
+	}
+
+	type args struct {
+		ctx     context.Context
+		keepId  string
+		mergeId string
+	}
+	tests := []Test[args, error]{
+		{
+			name: "merge a user into themselves",
+			args: args{
+				ctx:     context.Background(),
+				keepId:  keep.ID,
+				mergeId: keep.ID,
+			},
+			want:    repository.CannotMergeSameUser,
+			wantErr: true,
+		},
+		{
+			name: "merge a user that doesn't exist",
+			args: args{
+				ctx:     context.Background(),
+				keepId:  keep.ID,
+				mergeId: "123343",
+			},
+			want:    repository.UserNotFound,
+			wantErr: true,
+		},
+		{
+			name: "merge the last admin",
+			args: args{
+				ctx:     context.Background(),
+				keepId:  keep.ID,
+				mergeId: "3",
+			},
+			want:    repository.LastAdmin,
+			wantErr: true,
+		},
+		{
+			name: "merge Grace Hoper into Grace Hopper",
+			args: args{
+				ctx:     context.Background(),
+				keepId:  keep.ID,
+				mergeId: merge.ID,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := databaseRepository.MergeUsers(tt.args.ctx, tt.args.keepId, tt.args.mergeId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MergeUsers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if !errors.Is(err, tt.want) {
+					t.Errorf("MergeUsers() error = %v, want %v", err, tt.want)
+				}
+				return
+			}
+			if user.ID != keep.ID || user.Email != keep.Email {
+				t.Errorf("MergeUsers() user = %v, want %v", user, keep)
+			}
+		})
+	}
+
+	if _, err = databaseRepository.GetUserByID(context.Background(), merge.ID); !errors.Is(err, repository.UserNotFound) {
+		t.Errorf("GetUserByID() error = %v, want %v", err, repository.UserNotFound)
+	}
+	user, err := databaseRepository.GetUserByOAuthUID(context.Background(), "grace-gmail", model.OAuthProviderGmail)
+	if err != nil {
+		t.Fatalf("GetUserByOAuthUID() error = %v", err)
+	}
+	if user.ID != keep.ID {
+		t.Errorf("GetUserByOAuthUID() got user %s, want %s", user.ID, keep.ID)
+	}
+	mlh, err := databaseRepository.GetUserMLHTerms(context.Background(), keep.ID)
+	if err != nil {
+		t.Fatalf("GetUserMLHTerms() error = %v", err)
+	}
+	if !mlh.SendMessages || !mlh.CodeOfConduct || mlh.ShareInfo {
+		t.Errorf("GetUserMLHTerms() got = %v", mlh)
+	}
+	apiKeys, err := databaseRepository.GetAPIKeys(context.Background(), keep.ID)
+	if err != nil {
+		t.Fatalf("GetAPIKeys() error = %v", err)
+	}
+	// the admin scope is removed and the key that only had it is revoked
+	if len(apiKeys) != 1 || apiKeys[0].Name != "merged key" || !reflect.DeepEqual(apiKeys[0].Scopes, []model.APIKeyScope{model.APIKeyScopeReadProfile}) {
+		t.Errorf("GetAPIKeys() got = %v", apiKeys)
+	}
+	var applications int
+	if err = databaseRepository.DatabasePool.QueryRow(context.Background(), "SELECT count(*) FROM hackathon_applications WHERE user_id = $1", keep.ID).Scan(&applications); err != nil {
+		t.Fatalf("unable to count applications err = %v", err)
+	}
+	if applications != 1 {
+		t.Errorf("kept user has %d applications, want the merged user's one", applications)
+	}
+}
+
+func TestDatabaseRepository_RevokeSession(t *testing.T) {
+	refreshToken, err := databaseRepository.CreateSession(context.Background(), "1", utils.Ptr("integration test"))
+	if err != nil {
+		t.Fatalf("CreateSession() error = %v", err)
+	}
+
+	type args struct {
+		ctx          context.Context
+		refreshToken string
+	}
+	tests := []Test[args, any]{
+		{
+			name: "logout Joe Bob's session",
+			args: args{
+				ctx:          context.Background(),
+				refreshToken: refreshToken,
+			},
+			wantErr: false,
+		},
+		{
+			name: "logout an already logged out session",
+			args: args{
+				ctx:          context.Background(),
+				refreshToken: refreshToken,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := databaseRepository.RevokeSession(tt.args.ctx, tt.args.refreshToken); (err != nil) != tt.wantErr {
+				t.Errorf("RevokeSession() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, _, err = databaseRepository.RotateRefreshToken(context.Background(), refreshToken); !errors.Is(err, repository.RefreshTokenNotValid) {
+		t.Errorf("RotateRefreshToken() error = %v, want %v", err, repository.RefreshTokenNotValid)
+	}
+}
+
+func TestDatabaseRepository_RotateRefreshToken(t *testing.T) {
+	refreshToken, err := databaseRepository.CreateSession(context.Background(), "1", utils.Ptr("integration test"))
+	if err != nil {
+		t.Fatalf("CreateSession() error = %v", err)
+	}
+
+	userId, newRefreshToken, err := databaseRepository.RotateRefreshToken(context.Background(), refreshToken)
+	if err != nil {
+		t.Fatalf("RotateRefreshToken() error = %v", err)
+	}
+	if userId != "1" {
+		t.Errorf("RotateRefreshToken() userId = %v, want %v", userId, "1")
+	}
+	if newRefreshToken == refreshToken {
+		t.Errorf("RotateRefreshToken() did not rotate the refresh token")
+	}
+
+	sessions, err := databaseRepository.GetSessions(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetSessions() error = %v", err)
+	}
+	if len(sessions) != 1 {
+		t.Errorf("GetSessions() got %d sessions, want 1", len(sessions))
+	}
+
+	// reusing the first refresh token must log out the whole session
+	if _, _, err = databaseRepository.RotateRefreshToken(context.Background(), refreshToken); !errors.Is(err, repository.RefreshTokenReused) {
+		t.Errorf("RotateRefreshToken() error = %v, want %v", err, repository.RefreshTokenReused)
+	}
+	if _, _, err = databaseRepository.RotateRefreshToken(context.Background(), newRefreshToken); !errors.Is(err, repository.RefreshTokenNotValid) {
+		t.Errorf("RotateRefreshToken() error = %v, want %v", err, repository.RefreshTokenNotValid)
+	}
+}
+
+func TestDatabaseRepository_SearchUser(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		name string
+	}
+	tests := []Test[args, []*model.User]{
+
+		// TODO: Add test cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users, err := databaseRepository.SearchUser(tt.args.ctx, tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SearchUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
 			}
 			if !reflect.DeepEqual(users, tt.want) {
 				t.Errorf("SearchUser() users = %v, want %v", users, tt.want)
@@ -840,6 +1765,376 @@ func TestDatabaseRepository_SearchUser(t *testing.T) {
 	}
 }
 
+func TestDatabaseRepository_SetUserRole(t *testing.T) {
+	admin, err := databaseRepository.CreateUser(context.Background(), &model.OAuth{
+		Provider: model.OAuthProviderGithub,
+		UID:      "set-user-role",
+	}, &model.NewUser{
+		FirstName:   "Ada",
+		LastName:    "Admin",
+		Email:       "ada.admin@example.com",
+		PhoneNumber: "407-200-3001",
+		ShirtSize:   utils.Ptr(model.ShirtSizeS),
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to create user err = %v", err)
+	}
+
+	type args struct {
+		ctx       context.Context
+		id        string
+		role      models.Role
+		changedBy string
+	}
+	tests := []Test[args, models.Role]{
+		{
+			name: "promote Ada to admin",
+			args: args{
+				ctx:       context.Background(),
+				id:        admin.ID,
+				role:      models.RoleAdmin,
+				changedBy: admin.ID,
+			},
+			want:    models.RoleAdmin,
+			wantErr: false,
+		},
+		{
+			name: "demote Ada while dough boy is still an admin",
+			args: args{
+				ctx:       context.Background(),
+				id:        admin.ID,
+				role:      models.RoleNormal,
+				changedBy: "3",
+			},
+			want:    models.RoleNormal,
+			wantErr: false,
+		},
+		{
+			name: "demote the last admin",
+			args: args{
+				ctx:       context.Background(),
+				id:        "3",
+				role:      models.RoleNormal,
+				changedBy: "3",
+			},
+			wantErr: true,
+		},
+		{
+			name: "user that doesn't exist",
+			args: args{
+				ctx:       context.Background(),
+				id:        "123343",
+				role:      models.RoleSponsor,
+				changedBy: admin.ID,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := databaseRepository.SetUserRole(tt.args.ctx, tt.args.id, tt.args.role, tt.args.changedBy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetUserRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && user.Role != tt.want {
+				t.Errorf("SetUserRole() role = %v, want %v", user.Role, tt.want)
+			}
+		})
+	}
+
+	roleChanges, err := databaseRepository.GetRoleChanges(context.Background(), admin.ID)
+	if err != nil {
+		t.Fatalf("GetRoleChanges() error = %v", err)
+	}
+	if len(roleChanges) != 2 {
+		t.Fatalf("GetRoleChanges() got %d role changes, want 2", len(roleChanges))
+	}
+	if roleChanges[0].PreviousRole != models.RoleNormal || roleChanges[0].Role != models.RoleAdmin || roleChanges[0].ChangedBy.ID != admin.ID {
+		t.Errorf("GetRoleChanges() roleChange = %v", roleChanges[0])
+	}
+	if roleChanges[1].PreviousRole != models.RoleAdmin || roleChanges[1].Role != models.RoleNormal || roleChanges[1].ChangedBy.ID != "3" {
+		t.Errorf("GetRoleChanges() roleChange = %v", roleChanges[1])
+	}
+
+	// registering and each role change are versions of the user
+	versions, err := databaseRepository.GetUserVersions(context.Background(), admin.ID)
+	if err != nil {
+		t.Fatalf("GetUserVersions() error = %v", err)
+	}
+	if len(versions) != 3 || versions[1].User.Role != models.RoleAdmin || versions[2].User.Role != models.RoleNormal {
+		t.Errorf("GetUserVersions() = %+v, want a version for each role change", versions)
+	}
+}
+
+func TestDatabaseRepository_SetUserRoleConcurrent(t *testing.T) {
+	admin, err := databaseRepository.CreateUser(context.Background(), &model.OAuth{
+		Provider: model.OAuthProviderGithub,
+		UID:      "set-user-role-concurrent",
+	}, &model.NewUser{
+		FirstName:   "Alan",
+		LastName:    "Admin",
+		Email:       "alan.admin@example.com",
+		PhoneNumber: "407-200-3011",
+		ShirtSize:   utils.Ptr(model.ShirtSizeM),
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to create user err = %v", err)
+	}
+	if _, err = databaseRepository.SetUserRole(context.Background(), admin.ID, models.RoleAdmin, "3"); err != nil {
+		t.Fatalf("SetUserRole() error = %v", err)
+	}
+
+	// the two admins demote each other at the same time, exactly one of them must stay an admin
+	ids := []string{admin.ID, "3"}
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			_, errs[i] = databaseRepository.SetUserRole(context.Background(), id, models.RoleNormal, ids[1-i])
+		}(i, id)
+	}
+	wg.Wait()
+
+	var lastAdmins int
+	for _, err := range errs {
+		if errors.Is(err, repository.LastAdmin) {
+			lastAdmins++
+		} else if err != nil {
+			t.Errorf("SetUserRole() error = %v", err)
+		}
+	}
+	if lastAdmins != 1 {
+		t.Errorf("SetUserRole() got %d %v errors, want 1", lastAdmins, repository.LastAdmin)
+	}
+
+	// dough boy is the admin the other tests expect
+	if errs[1] == nil {
+		if _, err = databaseRepository.SetUserRole(context.Background(), "3", models.RoleAdmin, admin.ID); err != nil {
+			t.Fatalf("SetUserRole() error = %v", err)
+		}
+	}
+	if _, err = databaseRepository.SetUserRole(context.Background(), admin.ID, models.RoleNormal, "3"); err != nil && !errors.Is(err, repository.LastAdmin) {
+		t.Fatalf("SetUserRole() error = %v", err)
+	}
+}
+
+func TestDatabaseRepository_SoftDeleteUser(t *testing.T) {
+	user, err := databaseRepository.CreateUser(context.Background(), &model.OAuth{
+		Provider: model.OAuthProviderGithub,
+		UID:      "soft-delete",
+	}, &model.NewUser{
+		FirstName:   "Sam",
+		LastName:    "Deleted",
+		Email:       "sam.deleted@example.com",
+		PhoneNumber: "407-200-3002",
+		ShirtSize:   utils.Ptr(model.ShirtSizeL),
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to create user err = %v", err)
+	}
+
+	deletedAt, err := databaseRepository.SoftDeleteUser(context.Background(), user.ID)
+	if err != nil {
+		t.Fatalf("SoftDeleteUser() error = %v", err)
+	}
+	if _, err = databaseRepository.SoftDeleteUser(context.Background(), user.ID); !errors.Is(err, repository.UserNotFound) {
+		t.Errorf("SoftDeleteUser() error = %v, want %v", err, repository.UserNotFound)
+	}
+	if _, err = databaseRepository.GetUserByID(context.Background(), user.ID); !errors.Is(err, repository.UserNotFound) {
+		t.Errorf("GetUserByID() error = %v, want %v", err, repository.UserNotFound)
+	}
+	if _, err = databaseRepository.GetUserByOAuthUID(context.Background(), "soft-delete", model.OAuthProviderGithub); !errors.Is(err, repository.UserNotFound) {
+		t.Errorf("GetUserByOAuthUID() error = %v, want %v", err, repository.UserNotFound)
+	}
+
+	type args struct {
+		ctx          context.Context
+		id           string
+		deletedAfter time.Time
+	}
+	tests := []Test[args, error]{
+		{
+			name: "restore after the restore window",
+			args: args{
+				ctx:          context.Background(),
+				id:           user.ID,
+				deletedAfter: deletedAt,
+			},
+			want:    repository.RestoreWindowExpired,
+			wantErr: true,
+		},
+		{
+			name: "restore within the restore window",
+			args: args{
+				ctx:          context.Background(),
+				id:           user.ID,
+				deletedAfter: deletedAt.Add(-time.Hour),
+			},
+			wantErr: false,
+		},
+		{
+			name: "restore a user that isn't deleted",
+			args: args{
+				ctx:          context.Background(),
+				id:           user.ID,
+				deletedAfter: deletedAt.Add(-time.Hour),
+			},
+			want:    repository.UserNotDeleted,
+			wantErr: true,
+		},
+		{
+			name: "restore a user that doesn't exist",
+			args: args{
+				ctx:          context.Background(),
+				id:           "123343",
+				deletedAfter: deletedAt.Add(-time.Hour),
+			},
+			want:    repository.UserNotFound,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restored, err := databaseRepository.RestoreUser(tt.args.ctx, tt.args.id, tt.args.deletedAfter)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RestoreUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !errors.Is(err, tt.want) {
+				t.Errorf("RestoreUser() error = %v, want %v", err, tt.want)
+			}
+			if !tt.wantErr && restored.ID != user.ID {
+				t.Errorf("RestoreUser() user = %v, want %v", restored, user)
+			}
+		})
+	}
+
+	if _, err = databaseRepository.SoftDeleteUser(context.Background(), user.ID); err != nil {
+		t.Fatalf("SoftDeleteUser() error = %v", err)
+	}
+	purged, err := databaseRepository.PurgeDeletedUsers(context.Background(), time.Now().UTC().Add(time.Second))
+	if err != nil {
+		t.Fatalf("PurgeDeletedUsers() error = %v", err)
+	}
+	if purged != 1 {
+		t.Errorf("PurgeDeletedUsers() purged = %d, want 1", purged)
+	}
+	if _, err = databaseRepository.RestoreUser(context.Background(), user.ID, deletedAt.Add(-time.Hour)); !errors.Is(err, repository.UserNotFound) {
+		t.Errorf("RestoreUser() error = %v, want %v", err, repository.UserNotFound)
+	}
+}
+
+func TestDatabaseRepository_PurgeDeletedUsersContinues(t *testing.T) {
+	users := make([]*model.User, 0, 2)
+	for i, name := range []string{"Blocked", "Purged"} {
+		user, err := databaseRepository.CreateUser(context.Background(), &model.OAuth{
+			Provider: model.OAuthProviderGithub,
+			UID:      "purge-" + name,
+		}, &model.NewUser{
+			FirstName:   name,
+			LastName:    "Purge",
+			Email:       strings.ToLower(name) + ".purge@example.com",
+			PhoneNumber: fmt.Sprintf("407-555-015%d", i),
+			ShirtSize:   utils.Ptr(model.ShirtSizeM),
+		}, nil)
+		if err != nil {
+			t.Fatalf("unable to create user err = %v", err)
+		}
+		if _, err = databaseRepository.SoftDeleteUser(context.Background(), user.ID); err != nil {
+			t.Fatalf("SoftDeleteUser() error = %v", err)
+		}
+		users = append(users, user)
+	}
+	// a row the purge doesn't know about keeps the first user from being erased
+	_, err := databaseRepository.DatabasePool.Exec(context.Background(), "CREATE TABLE purge_blocker (user_id integer REFERENCES users)")
+	if err != nil {
+		t.Fatalf("unable to create table err = %v", err)
+	}
+	defer databaseRepository.DatabasePool.Exec(context.Background(), "DROP TABLE purge_blocker")
+	if _, err = databaseRepository.DatabasePool.Exec(context.Background(), "INSERT INTO purge_blocker VALUES ($1)", users[0].ID); err != nil {
+		t.Fatalf("unable to block user err = %v", err)
+	}
+
+	purged, err := databaseRepository.PurgeDeletedUsers(context.Background(), time.Now().UTC().Add(time.Second))
+	if err == nil || !strings.Contains(err.Error(), "user "+users[0].ID) {
+		t.Errorf("PurgeDeletedUsers() error = %v, want the error of user %s", err, users[0].ID)
+	}
+	if purged != 1 {
+		t.Errorf("PurgeDeletedUsers() purged = %d, want 1", purged)
+	}
+	if _, err = databaseRepository.RestoreUser(context.Background(), users[1].ID, time.Now().UTC().Add(-time.Hour)); !errors.Is(err, repository.UserNotFound) {
+		t.Errorf("RestoreUser() error = %v, want %v", err, repository.UserNotFound)
+	}
+
+	// once it is unblocked the next purge erases it
+	if _, err = databaseRepository.DatabasePool.Exec(context.Background(), "DELETE FROM purge_blocker"); err != nil {
+		t.Fatalf("unable to unblock user err = %v", err)
+	}
+	if purged, err = databaseRepository.PurgeDeletedUsers(context.Background(), time.Now().UTC().Add(time.Second)); err != nil || purged != 1 {
+		t.Errorf("PurgeDeletedUsers() = %d, %v, want 1, nil", purged, err)
+	}
+}
+
+func TestDatabaseRepository_SoftDeleteUserReleases(t *testing.T) {
+	if _, err := databaseRepository.SoftDeleteUser(context.Background(), "3"); !errors.Is(err, repository.LastAdmin) {
+		t.Errorf("SoftDeleteUser() error = %v, want %v", err, repository.LastAdmin)
+	}
+
+	oAuth := &model.OAuth{Provider: model.OAuthProviderGithub, UID: "soft-delete-releases"}
+	user, err := databaseRepository.CreateUser(context.Background(), oAuth, &model.NewUser{
+		FirstName:   "Rae",
+		LastName:    "Leased",
+		Email:       "rae.leased@example.com",
+		PhoneNumber: "407-200-3012",
+		ShirtSize:   utils.Ptr(model.ShirtSizeM),
+	}, nil)
+	if err != nil {
+		t.Fatalf("unable to create user err = %v", err)
+	}
+	payload, err := databaseRepository.AddAPIKey(context.Background(), user.ID, &model.NewAPIKey{
+		Name:   "soft deleted key",
+		Scopes: []model.APIKeyScope{model.APIKeyScopeReadProfile},
+	})
+	if err != nil {
+		t.Fatalf("AddAPIKey() error = %v", err)
+	}
+	deletedAt, err := databaseRepository.SoftDeleteUser(context.Background(), user.ID)
+	if err != nil {
+		t.Fatalf("SoftDeleteUser() error = %v", err)
+	}
+
+	// the key isn't used while its owner is deleted
+	if _, _, err = databaseRepository.GetUserByAPIKey(context.Background(), payload.Key); !errors.Is(err, repository.APIKeyNotFound) {
+		t.Errorf("GetUserByAPIKey() error = %v, want %v", err, repository.APIKeyNotFound)
+	}
+	var lastUsedAt *time.Time
+	err = databaseRepository.DatabasePool.QueryRow(context.Background(), "SELECT last_used_at FROM api_keys WHERE id = $1", payload.APIKey.ID).Scan(&lastUsedAt)
+	if err != nil || lastUsedAt != nil {
+		t.Errorf("last_used_at = %v, %v, want the key to never have been used", lastUsedAt, err)
+	}
+
+	// the identity can register again, after which the deleted user can't be restored with it
+	again, err := databaseRepository.CreateUser(context.Background(), oAuth, &model.NewUser{
+		FirstName:   "Rae",
+		LastName:    "Leased",
+		Email:       "rae.leased.again@example.com",
+		PhoneNumber: "407-200-3013",
+		ShirtSize:   utils.Ptr(model.ShirtSizeM),
+	}, nil)
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	if got, err := databaseRepository.GetUserByOAuthUID(context.Background(), oAuth.UID, oAuth.Provider); err != nil || got.ID != again.ID {
+		t.Errorf("GetUserByOAuthUID() = %v, %v, want %v", got, err, again.ID)
+	}
+	if _, err = databaseRepository.RestoreUser(context.Background(), user.ID, deletedAt.Add(-time.Hour)); !errors.Is(err, repository.OAuthIdentityAlreadyLinked) {
+		t.Errorf("RestoreUser() error = %v, want %v", err, repository.OAuthIdentityAlreadyLinked)
+	}
+}
+
 func TestDatabaseRepository_Set(t *testing.T) {
 	type args struct {
 		id       int
@@ -854,7 +2149,7 @@ func TestDatabaseRepository_Set(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			databaseRepository.Set(tt.args.id, tt.args.pronouns)
+			databaseRepository.Pronouns.Set(tt.args.id, tt.args.pronouns)
 		})
 	}
 }
@@ -1113,6 +2408,8 @@ func TestDatabaseRepository_UpdateUser(t *testing.T) {
 		ctx   context.Context
 		id    string
 		input *model.UpdatedUser
+
+		expectedVersion *int
 	}
 	tests := []Test[args, *model.User]{
 
@@ -1120,7 +2417,7 @@ func TestDatabaseRepository_UpdateUser(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := databaseRepository.UpdateUser(tt.args.ctx, tt.args.id, tt.args.input)
+			got, _, err := databaseRepository.UpdateUser(tt.args.ctx, tt.args.id, tt.args.input, tt.args.expectedVersion, time.Now().Add(time.Hour))
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UpdateUser() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1132,6 +2429,50 @@ func TestDatabaseRepository_UpdateUser(t *testing.T) {
 	}
 }
 
+func TestDatabaseRepository_UpdateUserVersionConflict(t *testing.T) {
+	user, err := databaseRepository.GetUserByID(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	updated, _, err := databaseRepository.UpdateUser(context.Background(), "1", &model.UpdatedUser{
+		LastName: utils.Ptr("Bobby"),
+	}, &user.Version, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+	if updated.Version != user.Version+1 {
+		t.Errorf("UpdateUser() version = %d, want %d", updated.Version, user.Version+1)
+	}
+
+	// the second update was made against the version read before the first one
+	_, _, err = databaseRepository.UpdateUser(context.Background(), "1", &model.UpdatedUser{
+		LastName: utils.Ptr("Bob"),
+		Email:    utils.Ptr("joe.stale@example.com"),
+	}, &user.Version, time.Now().Add(time.Hour))
+	var conflict *repository.VersionConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("UpdateUser() error = %v, want VersionConflictError", err)
+	}
+	if conflict.ExpectedVersion != user.Version || conflict.CurrentVersion != updated.Version {
+		t.Errorf("UpdateUser() conflict = %+v", conflict)
+	}
+	got, err := databaseRepository.GetUserByID(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if got.LastName != "Bobby" || got.Version != updated.Version {
+		t.Errorf("GetUserByID() got = %+v, the conflicting update was applied", got)
+	}
+	// the email change is started in the same transaction so it is rejected with the update
+	pendingEmail, err := databaseRepository.GetPendingEmail(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetPendingEmail() error = %v", err)
+	}
+	if pendingEmail != nil && *pendingEmail == "joe.stale@example.com" {
+		t.Errorf("GetPendingEmail() = %v, the conflicting email change was started", *pendingEmail)
+	}
+}
+
 func TestDatabaseRepository_UpdateYearsOfExperience(t *testing.T) {
 	type args struct {
 		ctx   context.Context
@@ -1153,6 +2494,440 @@ func TestDatabaseRepository_UpdateYearsOfExperience(t *testing.T) {
 	}
 }
 
+func TestDatabaseRepository_GetAuditLog(t *testing.T) {
+	ctx := repository.WithActor(context.Background(), repository.Actor{
+		UserID:    "3",
+		Role:      models.RoleAdmin,
+		RequestID: "audit-log-test",
+	})
+	_, emailChangeToken, err := databaseRepository.UpdateUser(ctx, "1", &model.UpdatedUser{
+		FirstName: utils.Ptr("Joseph"),
+		Email:     utils.Ptr("joseph.bob@example.com"),
+		ShirtSize: utils.Ptr(model.ShirtSizeXs),
+	}, nil, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+	// the email is only changed, and logged, once the change is confirmed
+	if _, err = databaseRepository.ConfirmEmailChange(ctx, emailChangeToken); err != nil {
+		t.Fatalf("ConfirmEmailChange() error = %v", err)
+	}
+
+	auditLog, err := databaseRepository.GetAuditLog(context.Background(), "1", 2, nil)
+	if err != nil {
+		t.Fatalf("GetAuditLog() error = %v", err)
+	}
+	entries, total := auditLog.Entries, auditLog.TotalCount
+	if len(entries) != 2 || total < 2 {
+		t.Fatalf("GetAuditLog() got %d entries and a total of %d", len(entries), total)
+	}
+	if auditLog.PageInfo.HasPreviousPage || auditLog.PageInfo.HasNextPage != (total > 2) {
+		t.Errorf("GetAuditLog() page info = %+v with a total of %d", auditLog.PageInfo, total)
+	}
+	for _, entry := range entries {
+		if entry.Operation != model.AuditOperationUpdateUser || *entry.ActorID != "3" || *entry.ActorRole != models.RoleAdmin || *entry.RequestID != "audit-log-test" {
+			t.Errorf("GetAuditLog() entry = %+v", entry)
+		}
+	}
+	confirmed, entry := entries[0], entries[1]
+	if len(confirmed.Changes) == 0 || !reflect.DeepEqual(confirmed.Changes[0], &model.FieldChange{Field: "email", Before: utils.Ptr(database.Redacted), After: utils.Ptr(database.Redacted)}) {
+		t.Errorf("GetAuditLog() confirmed email changes = %v", confirmed.Changes)
+	}
+	wantChanges := []*model.FieldChange{
+		{Field: "firstName", Before: utils.Ptr(database.Redacted), After: utils.Ptr(database.Redacted)},
+	}
+	if len(entry.Changes) != 2 || !reflect.DeepEqual(entry.Changes[:1], wantChanges) {
+		t.Fatalf("GetAuditLog() changes = %v, want %v and the shirt size", entry.Changes, wantChanges)
+	}
+	// fields without personal information are not redacted
+	if shirtSize := entry.Changes[1]; shirtSize.Field != "shirtSize" || shirtSize.After == nil || *shirtSize.After != "XS" {
+		t.Errorf("GetAuditLog() shirt size change = %+v", shirtSize)
+	}
+
+	endCursor, err := cursor.Decode(auditLog.PageInfo.EndCursor)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	// the users query sorts by the same ids but its cursors are for a different list
+	if _, err = databaseRepository.GetAuditLog(context.Background(), "1", 1, &cursor.Cursor{Sort: model.UserSortID.String(), ID: entry.ID}); !errors.Is(err, cursor.NotValid) {
+		t.Errorf("GetAuditLog() error = %v, want %v", err, cursor.NotValid)
+	}
+	if total > 2 {
+		olderLog, err := databaseRepository.GetAuditLog(context.Background(), "1", 1, endCursor)
+		if err != nil {
+			t.Fatalf("GetAuditLog() error = %v", err)
+		}
+		if len(olderLog.Entries) != 1 || olderLog.Entries[0].ID == entry.ID || !olderLog.PageInfo.HasPreviousPage {
+			t.Errorf("GetAuditLog() after %s got = %v, page info = %+v", entry.ID, olderLog.Entries, olderLog.PageInfo)
+		}
+	}
+}
+
+func TestDatabaseRepository_GetUserVersions(t *testing.T) {
+	before, err := databaseRepository.GetUserVersions(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetUserVersions() error = %v", err)
+	}
+	_, _, err = databaseRepository.UpdateUser(context.Background(), "1", &model.UpdatedUser{
+		FirstName: utils.Ptr("Joseph"),
+		ShirtSize: utils.Ptr(model.ShirtSizeXl),
+	}, nil, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+	changedAt := time.Now()
+	_, _, err = databaseRepository.UpdateUser(context.Background(), "1", &model.UpdatedUser{
+		FirstName: utils.Ptr("Joseph"),
+		ShirtSize: utils.Ptr(model.ShirtSizeS),
+	}, nil, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	versions, err := databaseRepository.GetUserVersions(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetUserVersions() error = %v", err)
+	}
+	if len(versions) != len(before)+2 {
+		t.Fatalf("GetUserVersions() got %d versions, want %d", len(versions), len(before)+2)
+	}
+	latest := versions[len(versions)-1]
+	if latest.Version != versions[len(versions)-2].Version+1 || *latest.User.ShirtSize != model.ShirtSizeS {
+		t.Errorf("GetUserVersions() latest = %+v", latest)
+	}
+
+	asOf, err := databaseRepository.GetUserVersionAsOf(context.Background(), "1", changedAt)
+	if err != nil {
+		t.Fatalf("GetUserVersionAsOf() error = %v", err)
+	}
+	if asOf == nil || asOf.Version != latest.Version-1 || *asOf.User.ShirtSize != model.ShirtSizeXl {
+		t.Errorf("GetUserVersionAsOf() = %+v, want version %d", asOf, latest.Version-1)
+	}
+
+	asOf, err = databaseRepository.GetUserVersionAsOf(context.Background(), "1", time.Time{})
+	if err != nil {
+		t.Fatalf("GetUserVersionAsOf() error = %v", err)
+	}
+	if asOf != nil {
+		t.Errorf("GetUserVersionAsOf() = %+v, want nil", asOf)
+	}
+}
+
+func TestDatabaseRepository_EmailChange(t *testing.T) {
+	_, err := databaseRepository.CreateEmailChange(context.Background(), "3", "DoughBoy@gmail.com", time.Now().Add(time.Hour))
+	if !errors.Is(err, repository.EmailUnchanged) {
+		t.Errorf("CreateEmailChange() error = %v, want %v", err, repository.EmailUnchanged)
+	}
+	_, err = databaseRepository.CreateEmailChange(context.Background(), "3", "Joseph.Bob@Example.com", time.Now().Add(time.Hour))
+	if !errors.Is(err, repository.EmailAlreadyInUse) {
+		t.Errorf("CreateEmailChange() error = %v, want %v", err, repository.EmailAlreadyInUse)
+	}
+
+	expired, err := databaseRepository.CreateEmailChange(context.Background(), "3", "dough.expired@example.com", time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("CreateEmailChange() error = %v", err)
+	}
+	if _, err = databaseRepository.ConfirmEmailChange(context.Background(), expired); !errors.Is(err, repository.EmailChangeNotValid) {
+		t.Errorf("ConfirmEmailChange() error = %v, want %v", err, repository.EmailChangeNotValid)
+	}
+
+	token, err := databaseRepository.CreateEmailChange(context.Background(), "3", " Dough.Boy@Example.com", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("CreateEmailChange() error = %v", err)
+	}
+	pendingEmail, err := databaseRepository.GetPendingEmail(context.Background(), "3")
+	if err != nil {
+		t.Fatalf("GetPendingEmail() error = %v", err)
+	}
+	if pendingEmail == nil || *pendingEmail != "dough.boy@example.com" {
+		t.Errorf("GetPendingEmail() = %v, want dough.boy@example.com", pendingEmail)
+	}
+	user, err := databaseRepository.GetUserByID(context.Background(), "3")
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if user.Email != "doughboy@gmail.com" {
+		t.Errorf("GetUserByID() email = %v, the email was changed before it was confirmed", user.Email)
+	}
+
+	user, err = databaseRepository.ConfirmEmailChange(context.Background(), token)
+	if err != nil {
+		t.Fatalf("ConfirmEmailChange() error = %v", err)
+	}
+	if user.Email != "dough.boy@example.com" {
+		t.Errorf("ConfirmEmailChange() email = %v, want dough.boy@example.com", user.Email)
+	}
+	if !user.EmailVerified {
+		t.Errorf("ConfirmEmailChange() did not verify the new email")
+	}
+	if _, err = databaseRepository.ConfirmEmailChange(context.Background(), token); !errors.Is(err, repository.EmailChangeNotValid) {
+		t.Errorf("ConfirmEmailChange() error = %v, want %v", err, repository.EmailChangeNotValid)
+	}
+	if pendingEmail, err = databaseRepository.GetPendingEmail(context.Background(), "3"); err != nil || pendingEmail != nil {
+		t.Errorf("GetPendingEmail() = %v, %v, want nil", pendingEmail, err)
+	}
+
+	// emails are unique regardless of case
+	_, err = databaseRepository.CreateUser(context.Background(), &model.OAuth{
+		Provider: model.OAuthProviderGithub,
+		UID:      "email-case",
+	}, &model.NewUser{
+		FirstName:   "Dough",
+		LastName:    "Boy",
+		Email:       "DOUGH.BOY@example.com",
+		PhoneNumber: "407-200-3003",
+		ShirtSize:   utils.Ptr(model.ShirtSizeS),
+	}, nil)
+	if err == nil {
+		t.Errorf("CreateUser() created a user with an email that differs only by case")
+	}
+}
+
+func TestDatabaseRepository_EmailVerification(t *testing.T) {
+	verified, err := databaseRepository.IsEmailVerified(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("IsEmailVerified() error = %v", err)
+	}
+	if verified {
+		t.Errorf("IsEmailVerified() = true, registered users start unverified")
+	}
+	if verified, err = databaseRepository.MarkEmailVerified(context.Background(), "1", "someone.else@example.com"); err != nil || verified {
+		t.Errorf("MarkEmailVerified() = %v, %v, want false as the email is not the user's", verified, err)
+	}
+
+	expired, _, err := databaseRepository.CreateEmailVerification(context.Background(), "1", time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("CreateEmailVerification() error = %v", err)
+	}
+	if _, err = databaseRepository.VerifyEmail(context.Background(), expired); !errors.Is(err, repository.EmailVerificationNotValid) {
+		t.Errorf("VerifyEmail() error = %v, want %v", err, repository.EmailVerificationNotValid)
+	}
+
+	token, email, err := databaseRepository.CreateEmailVerification(context.Background(), "1", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("CreateEmailVerification() error = %v", err)
+	}
+	if email != "joseph.bob@example.com" {
+		t.Errorf("CreateEmailVerification() email = %v, want joseph.bob@example.com", email)
+	}
+	user, err := databaseRepository.VerifyEmail(context.Background(), token)
+	if err != nil {
+		t.Fatalf("VerifyEmail() error = %v", err)
+	}
+	if !user.EmailVerified {
+		t.Errorf("VerifyEmail() did not verify the email")
+	}
+	if _, err = databaseRepository.VerifyEmail(context.Background(), token); !errors.Is(err, repository.EmailVerificationNotValid) {
+		t.Errorf("VerifyEmail() error = %v, want %v", err, repository.EmailVerificationNotValid)
+	}
+	if _, _, err = databaseRepository.CreateEmailVerification(context.Background(), "1", time.Now().Add(time.Hour)); !errors.Is(err, repository.EmailAlreadyVerified) {
+		t.Errorf("CreateEmailVerification() error = %v, want %v", err, repository.EmailAlreadyVerified)
+	}
+}
+
+func TestDatabaseRepository_BatchGetters(t *testing.T) {
+	ids := []string{"1", "312345644"}
+	users, err := databaseRepository.GetUsersByIDs(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("GetUsersByIDs() error = %v", err)
+	}
+	want, err := databaseRepository.GetUserByID(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if !reflect.DeepEqual(users["1"], want) {
+		t.Errorf("GetUsersByIDs() got = %v, want %v", users["1"], want)
+	}
+	if _, ok := users["312345644"]; ok {
+		t.Errorf("GetUsersByIDs() returned a user that doesn't exist")
+	}
+
+	linked := model.OAuth{Provider: model.OAuthProviderGithub, UID: "1"}
+	unlinked := model.OAuth{Provider: model.OAuthProviderGmail, UID: "1"}
+	oAuthUsers, err := databaseRepository.GetUsersByOAuthIdentities(context.Background(), []model.OAuth{linked, unlinked})
+	if err != nil {
+		t.Fatalf("GetUsersByOAuthIdentities() error = %v", err)
+	}
+	wantOAuthUser, err := databaseRepository.GetUserByOAuthUID(context.Background(), linked.UID, linked.Provider)
+	if err != nil {
+		t.Fatalf("GetUserByOAuthUID() error = %v", err)
+	}
+	if !reflect.DeepEqual(oAuthUsers[linked], wantOAuthUser) {
+		t.Errorf("GetUsersByOAuthIdentities() got = %v, want %v", oAuthUsers[linked], wantOAuthUser)
+	}
+	if _, ok := oAuthUsers[unlinked]; ok {
+		t.Errorf("GetUsersByOAuthIdentities() returned a user for an identity that isn't linked")
+	}
+
+	// the batch getters must return the same as the getters for a single user
+	oAuths, err := databaseRepository.GetOAuths(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("GetOAuths() error = %v", err)
+	}
+	oAuth, err := databaseRepository.GetOAuth(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetOAuth() error = %v", err)
+	}
+	if !reflect.DeepEqual(oAuths["1"], oAuth) {
+		t.Errorf("GetOAuths() got = %v, want %v", oAuths["1"], oAuth)
+	}
+
+	mailingAddresses, err := databaseRepository.GetMailingAddresses(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("GetMailingAddresses() error = %v", err)
+	}
+	mailingAddress, err := databaseRepository.GetUserMailingAddress(context.Background(), "1")
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("GetUserMailingAddress() error = %v", err)
+	}
+	if !reflect.DeepEqual(mailingAddresses["1"], mailingAddress) {
+		t.Errorf("GetMailingAddresses() got = %v, want %v", mailingAddresses["1"], mailingAddress)
+	}
+
+	mlhTerms, err := databaseRepository.GetMLHTerms(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("GetMLHTerms() error = %v", err)
+	}
+	terms, err := databaseRepository.GetUserMLHTerms(context.Background(), "1")
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("GetUserMLHTerms() error = %v", err)
+	}
+	if !reflect.DeepEqual(mlhTerms["1"], terms) {
+		t.Errorf("GetMLHTerms() got = %v, want %v", mlhTerms["1"], terms)
+	}
+
+	educationInfos, err := databaseRepository.GetEducationInfos(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("GetEducationInfos() error = %v", err)
+	}
+	educationInfo, err := databaseRepository.GetUserEducationInfo(context.Background(), "1")
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("GetUserEducationInfo() error = %v", err)
+	}
+	if !reflect.DeepEqual(educationInfos["1"], educationInfo) {
+		t.Errorf("GetEducationInfos() got = %v, want %v", educationInfos["1"], educationInfo)
+	}
+
+	apiKeys, err := databaseRepository.GetAPIKeysByUserIDs(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("GetAPIKeysByUserIDs() error = %v", err)
+	}
+	for _, id := range ids {
+		keys, err := databaseRepository.GetAPIKeys(context.Background(), id)
+		if err != nil {
+			t.Fatalf("GetAPIKeys() error = %v", err)
+		}
+		if len(apiKeys[id]) != len(keys) {
+			t.Errorf("GetAPIKeysByUserIDs() got %d keys for %s, want %d", len(apiKeys[id]), id, len(keys))
+		}
+	}
+}
+
+func TestOAuthStateStore(t *testing.T) {
+	store := database.NewOAuthStateStore(databaseRepository.DatabasePool)
+	state, err := oauth.NewState(model.OAuthProviderDiscord, utils.Ptr("http://localhost:3000/callback"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Save(context.Background(), state); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	got, err := store.Take(context.Background(), state.State)
+	if err != nil {
+		t.Fatalf("Take() error = %v", err)
+	}
+	if got.Provider != state.Provider || got.CodeVerifier != state.CodeVerifier || *got.RedirectURL != *state.RedirectURL {
+		t.Errorf("Take() got = %v, want %v", got, state)
+	}
+	if _, err = store.Take(context.Background(), state.State); !errors.Is(err, oauth.StateNotValid) {
+		t.Errorf("Take() error = %v, want %v as a state can only be used once", err, oauth.StateNotValid)
+	}
+
+	expired, err := oauth.NewState(model.OAuthProviderDiscord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired.ExpiresAt = time.Now().Add(-time.Second)
+	if err = store.Save(context.Background(), expired); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if _, err = store.Take(context.Background(), expired.State); !errors.Is(err, oauth.StateNotValid) {
+		t.Errorf("Take() error = %v, want %v", err, oauth.StateNotValid)
+	}
+}
+
+func TestDatabaseRepository_BackfillPhoneNumbers(t *testing.T) {
+	// these are inserted directly as they would have been stored before phone numbers were normalized
+	var collidingIds [2]int
+	for i, number := range []string{"(407) 555-0177", "407.555.0177"} {
+		err := databaseRepository.DatabasePool.QueryRow(context.Background(),
+			"INSERT INTO users (first_name, last_name, email, phone_number, role, shirt_size) VALUES ('Colliding', 'Number', $1, $2, 'NORMAL', 'M') RETURNING id",
+			fmt.Sprintf("colliding.number.%d@example.com", i),
+			number,
+		).Scan(&collidingIds[i])
+		if err != nil {
+			t.Fatalf("unable to insert user err = %v", err)
+		}
+	}
+	var legacyId string
+	err := databaseRepository.DatabasePool.QueryRow(context.Background(),
+		"INSERT INTO users (first_name, last_name, email, phone_number, role, shirt_size) VALUES ('Legacy', 'Number', 'legacy.number@example.com', '4075550178', 'NORMAL', 'M') RETURNING id::varchar",
+	).Scan(&legacyId)
+	if err != nil {
+		t.Fatalf("unable to insert user err = %v", err)
+	}
+
+	dryRun, err := databaseRepository.BackfillPhoneNumbers(context.Background(), true)
+	if err != nil {
+		t.Fatalf("BackfillPhoneNumbers() error = %v", err)
+	}
+	user, err := databaseRepository.GetUserByID(context.Background(), legacyId)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if user.PhoneNumber != "4075550178" {
+		t.Errorf("BackfillPhoneNumbers() changed %v on a dry run", user.PhoneNumber)
+	}
+
+	report, err := databaseRepository.BackfillPhoneNumbers(context.Background(), false)
+	if err != nil {
+		t.Fatalf("BackfillPhoneNumbers() error = %v", err)
+	}
+	if !reflect.DeepEqual(report, dryRun) {
+		t.Errorf("BackfillPhoneNumbers() = %+v, the dry run reported %+v", report, dryRun)
+	}
+	if report.Invalid["1"] != "100-200-3000" {
+		t.Errorf("BackfillPhoneNumbers() invalid = %v, want user 1", report.Invalid)
+	}
+	// 123 is not a valid exchange code of a North American number
+	if report.Invalid["3"] != "4071234567" {
+		t.Errorf("BackfillPhoneNumbers() invalid = %v, want user 3", report.Invalid)
+	}
+	wantCollision := &database.PhoneNumberCollision{
+		PhoneNumber: "+14075550177",
+		UserIDs:     []string{strconv.Itoa(collidingIds[0]), strconv.Itoa(collidingIds[1])},
+	}
+	if len(report.Collisions) != 1 || !reflect.DeepEqual(report.Collisions[0], wantCollision) {
+		t.Errorf("BackfillPhoneNumbers() collisions = %v, want %v", report.Collisions, wantCollision)
+	}
+
+	user, err = databaseRepository.GetUserByID(context.Background(), legacyId)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if user.PhoneNumber != "+14075550178" {
+		t.Errorf("BackfillPhoneNumbers() phone number = %v, want +14075550178", user.PhoneNumber)
+	}
+
+	again, err := databaseRepository.BackfillPhoneNumbers(context.Background(), false)
+	if err != nil {
+		t.Fatalf("BackfillPhoneNumbers() error = %v", err)
+	}
+	if again.Updated != 0 {
+		t.Errorf("BackfillPhoneNumbers() updated %d numbers that were already normalized", again.Updated)
+	}
+}
+
 func TestNewDatabaseRepository(t *testing.T) {
 	type args struct {
 		databasePool *pgxpool.Pool
@@ -1164,9 +2939,8 @@ func TestNewDatabaseRepository(t *testing.T) {
 				databasePool: databaseRepository.DatabasePool,
 			},
 			want: &database.DatabaseRepository{
-				DatabasePool:      databaseRepository.DatabasePool,
-				PronounMap:        databaseRepository.PronounMap,
-				PronounReverseMap: databaseRepository.PronounReverseMap,
+				DatabasePool: databaseRepository.DatabasePool,
+				Pronouns:     databaseRepository.Pronouns,
 			},
 			wantErr: false,
 		},
@@ -1209,3 +2983,98 @@ func TestScanUser(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabaseRepository_PronounOptions(t *testing.T) {
+	options, err := databaseRepository.GetPronounOptions(context.Background(), false)
+	if err != nil {
+		t.Fatalf("GetPronounOptions() error = %v", err)
+	}
+	if len(options) != 4 || !options[len(options)-1].Pronouns.AskMe {
+		t.Fatalf("GetPronounOptions() got = %v, want the 4 seeded options with ask me last", options)
+	}
+
+	option, err := databaseRepository.AddPronounOption(context.Background(), &model.PronounsInput{Subjective: " Xe ", Objective: "XEM", Possessive: utils.Ptr("xyr")})
+	if err != nil {
+		t.Fatalf("AddPronounOption() error = %v", err)
+	}
+	want := &model.Pronouns{Subjective: "xe", Objective: "xem", Possessive: utils.Ptr("xyr")}
+	if !reflect.DeepEqual(option.Pronouns, want) || option.Retired {
+		t.Errorf("AddPronounOption() got = %v, want %v", option.Pronouns, want)
+	}
+	again, err := databaseRepository.AddPronounOption(context.Background(), &model.PronounsInput{Subjective: "xe", Objective: "xem ", Possessive: utils.Ptr("Xyr")})
+	if err != nil {
+		t.Fatalf("AddPronounOption() error = %v", err)
+	}
+	if again.ID != option.ID {
+		t.Errorf("AddPronounOption() id = %v, want %v as the pronouns only differ by case and whitespace", again.ID, option.ID)
+	}
+
+	retired, err := databaseRepository.RetirePronounOption(context.Background(), option.ID)
+	if err != nil {
+		t.Fatalf("RetirePronounOption() error = %v", err)
+	}
+	if !retired.Retired {
+		t.Errorf("RetirePronounOption() retired = false, want true")
+	}
+	if options, err = databaseRepository.GetPronounOptions(context.Background(), false); err != nil || len(options) != 4 {
+		t.Errorf("GetPronounOptions() got %d options, error = %v, want the retired option left out", len(options), err)
+	}
+	if options, err = databaseRepository.GetPronounOptions(context.Background(), true); err != nil || len(options) != 5 {
+		t.Errorf("GetPronounOptions() got %d options, error = %v, want the retired option included", len(options), err)
+	}
+	if _, err = databaseRepository.RetirePronounOption(context.Background(), "312345644"); !errors.Is(err, repository.PronounNotFound) {
+		t.Errorf("RetirePronounOption() error = %v, want %v", err, repository.PronounNotFound)
+	}
+
+	// pronouns differing only by case or whitespace can't be stored twice
+	_, err = databaseRepository.DatabasePool.Exec(context.Background(), "INSERT INTO pronouns (subjective, objective) VALUES ('He ', 'Him')")
+	if err == nil {
+		t.Errorf("INSERT INTO pronouns stored a duplicate of he/him")
+	}
+	// pronouns an admin considers the same as he/him
+	var duplicateId int
+	err = databaseRepository.DatabasePool.QueryRow(context.Background(), "INSERT INTO pronouns (subjective, objective, possessive) VALUES ('he', 'him', 'his') RETURNING id").Scan(&duplicateId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = databaseRepository.DatabasePool.Exec(context.Background(), "UPDATE users SET pronoun_id = $1 WHERE id = 1", duplicateId); err != nil {
+		t.Fatal(err)
+	}
+	before, err := databaseRepository.GetUserByID(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	auditLogBefore, err := databaseRepository.GetAuditLog(context.Background(), "1", 1, nil)
+	if err != nil {
+		t.Fatalf("GetAuditLog() error = %v", err)
+	}
+	if _, err = databaseRepository.MergePronouns(context.Background(), "1", "1"); !errors.Is(err, repository.CannotMergeSamePronouns) {
+		t.Errorf("MergePronouns() error = %v, want %v", err, repository.CannotMergeSamePronouns)
+	}
+	if _, err = databaseRepository.MergePronouns(context.Background(), "1", strconv.Itoa(duplicateId)); err != nil {
+		t.Fatalf("MergePronouns() error = %v", err)
+	}
+	user, err := databaseRepository.GetUserByID(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if user.Pronouns == nil || user.Pronouns.Subjective != "he" || user.Pronouns.Possessive != nil {
+		t.Errorf("MergePronouns() user pronouns = %v, want he/him", user.Pronouns)
+	}
+	if user.Version != before.Version+1 {
+		t.Errorf("MergePronouns() user version = %d, want %d", user.Version, before.Version+1)
+	}
+	auditLog, err := databaseRepository.GetAuditLog(context.Background(), "1", 1, nil)
+	if err != nil {
+		t.Fatalf("GetAuditLog() error = %v", err)
+	}
+	if auditLog.TotalCount != auditLogBefore.TotalCount+1 || auditLog.Entries[0].Operation != model.AuditOperationUpdateUser {
+		t.Errorf("GetAuditLog() got = %v, want an entry for the merge", auditLog.Entries)
+	}
+	if _, exists := databaseRepository.Pronouns.GetByID(duplicateId); exists {
+		t.Errorf("GetByID() found pronouns that were merged")
+	}
+	if _, err = databaseRepository.MergePronouns(context.Background(), "1", strconv.Itoa(duplicateId)); !errors.Is(err, repository.PronounNotFound) {
+		t.Errorf("MergePronouns() error = %v, want %v", err, repository.PronounNotFound)
+	}
+}
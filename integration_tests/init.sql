@@ -1,4 +1,6 @@
 -- SCHEMA START
+create extension if not exists pg_trgm;
+
 create type semester as enum ('FALL', 'SPRING', 'SUMMER');
 
 create type subscription_tier as enum ('BRONZE', 'SILVER', 'GOLD', 'PLATINUM');
@@ -57,13 +59,25 @@ create table pronouns
     id         serial
         constraint pronouns_pk
             primary key,
-    subjective varchar not null,
-    objective  varchar not null
+    subjective varchar               not null,
+    objective  varchar               not null,
+    possessive varchar,
+    ask_me     boolean default false not null,
+    curated    boolean default false not null,
+    retired_at timestamp
 );
 
 create unique index pronouns_id_uindex
     on pronouns (id);
 
+-- pronouns that only differ by case or whitespace are the same, the expressions must stay the same
+-- as normalizedPronoun in pronouns.go. Existing databases are migrated with migrations/pronouns.sql
+create unique index pronouns_normalized_uindex
+    on pronouns (lower(btrim(regexp_replace(coalesce(subjective, ''), '\s+', ' ', 'g'))),
+                 lower(btrim(regexp_replace(coalesce(objective, ''), '\s+', ' ', 'g'))),
+                 lower(btrim(regexp_replace(coalesce(possessive, ''), '\s+', ' ', 'g'))),
+                 ask_me);
+
 create table users
 (
     id                  serial
@@ -73,25 +87,87 @@ create table users
     phone_number        varchar,
     last_name           varchar not null,
     age                 integer,
-        pronoun_id          integer,
+        pronoun_id          integer
+            constraint users_pronouns_id_fk
+                references pronouns,
     first_name          varchar not null,
     role                varchar not null,
-    oauth_uid           varchar not null
-        constraint users_oauth_uid_unique
-            unique,
-    oauth_provider      varchar not null,
     years_of_experience double precision,
     shirt_size          varchar not null,
     race                character varying[],
-    gender              varchar
+    gender              varchar,
+    version             integer default 1 not null,
+    -- existing databases are migrated with migrations/email_verified.sql
+    email_verified      boolean default false not null,
+    -- existing databases are migrated with migrations/prefilled_fields.sql
+    prefilled_fields    varchar[] default '{}' not null,
+    -- existing databases are migrated with migrations/users_deleted_at.sql
+    deleted_at          timestamp,
+    -- null for users that registered before it was recorded, existing databases are migrated with
+    -- migrations/users_created.sql
+    created             timestamp default timezone('utc', now())
 );
 
+-- emails are compared case-insensitively, existing databases are migrated with
+-- migrations/lowercase_emails.sql
 create unique index users_email_uindex
-    on users (email);
+    on users (lower(email));
 
 create unique index users_phone_number_uindex
     on users (phone_number);
 
+-- the indexes below are used by the filters and sorts of the users query, existing databases are
+-- migrated with migrations/users_query_indexes.sql
+create index users_created_index
+    on users (created);
+
+create index users_newest_index
+    on users (coalesce(created, '-infinity'), id);
+
+create index users_role_index
+    on users (role);
+
+create index users_first_name_index
+    on users (lower(first_name), id);
+
+create index users_last_name_index
+    on users (lower(last_name), id);
+
+create index users_age_index
+    on users (coalesce(age, -1), id);
+
+-- the indexes below are used to find duplicate users, the expressions must be the same as the
+-- ones in merge_users.go. Existing databases are migrated with migrations/duplicate_users_indexes.sql
+create index users_duplicate_email_index
+    on users (regexp_replace(lower(trim(email)), '\+[^@]*@', '@'));
+
+create index users_duplicate_phone_number_index
+    on users (nullif(right(regexp_replace(coalesce(phone_number, ''), '[^0-9]', '', 'g'), 10), ''));
+
+create index users_duplicate_name_index
+    on users using gin (lower(first_name || ' ' || last_name) gin_trgm_ops);
+
+-- existing databases are migrated with migrations/oauth_identities.sql and then
+-- migrations/oauth_identities_deleted_at.sql
+create table oauth_identities
+(
+    user_id   integer   not null
+        constraint oauth_identities_users_id_fk
+            references users
+            on delete cascade,
+    provider   varchar   not null,
+    uid        varchar   not null,
+    linked_at  timestamp not null,
+    -- set while the user is soft deleted so the identity can register another user
+    deleted_at timestamp,
+    constraint oauth_identities_pk
+        primary key (user_id, provider)
+);
+
+create unique index oauth_identities_provider_uid_uindex
+    on oauth_identities (provider, uid)
+    where deleted_at is null;
+
 create table hackathon_sponsors
 (
     hackathon_id integer not null
@@ -150,6 +226,10 @@ create table mailing_addresses
     address_lines character varying[] not null
 );
 
+-- used by the country and state filters of the users query
+create index mailing_addresses_country_state_index
+    on mailing_addresses (lower(country), lower(state));
+
 create table mlh_terms
 (
     user_id         integer not null
@@ -175,6 +255,13 @@ create table education_info
     level           varchar
 );
 
+-- used by the filters of the users query
+create index education_info_graduation_date_index
+    on education_info (graduation_date);
+
+create index education_info_level_index
+    on education_info (level);
+
 create table event_attendance
 (
     event_id integer                 not null
@@ -214,56 +301,247 @@ create table hackathon_checkin
         primary key (hackathon_id, user_id)
 );
 
+-- existing databases are migrated with migrations/anonymous_attendance.sql
+create table anonymous_hackathon_checkin
+(
+    hackathon_id integer   not null
+        constraint anonymous_hackathon_checkin_hackathons_id_fk
+            references hackathons,
+    time         timestamp not null
+);
+
+create table anonymous_event_attendance
+(
+    event_id integer   not null
+        constraint anonymous_event_attendance_events_id_fk
+            references events,
+    time     timestamp not null
+);
+
+-- existing databases are migrated with migrations/api_keys.sql
 create table api_keys
 (
-    user_id integer   not null
+    id           serial
         constraint api_keys_pk
-            primary key
+            primary key,
+    user_id      integer             not null
         constraint api_keys_users_id_fk
             references users,
-    key     varchar   not null,
-    created timestamp not null
+    name         varchar             not null,
+    key_hash     varchar             not null,
+    scopes       character varying[] not null,
+    created      timestamp           not null,
+    expires_at   timestamp,
+    last_used_at timestamp
 );
 
-create unique index api_keys_key_uindex
-    on api_keys (key);
+create unique index api_keys_key_hash_uindex
+    on api_keys (key_hash);
+
+create index api_keys_user_id_index
+    on api_keys (user_id);
+
+-- existing databases are migrated with migrations/role_changes.sql
+create table role_changes
+(
+    id            serial
+        constraint role_changes_pk
+            primary key,
+    user_id       integer   not null
+        constraint role_changes_users_id_fk
+            references users,
+    previous_role varchar   not null,
+    role          varchar   not null,
+    changed_by    integer
+        constraint role_changes_users_changed_by_fk
+            references users
+            on delete set null,
+    changed_at    timestamp not null
+);
+
+create index role_changes_user_id_index
+    on role_changes (user_id);
+
+-- existing databases are migrated with migrations/oauth_states.sql
+create table oauth_states
+(
+    state_hash    varchar   not null
+        constraint oauth_states_pk
+            primary key,
+    provider      varchar   not null,
+    code_verifier varchar   not null,
+    redirect_url  varchar,
+    expires_at    timestamp not null
+);
+
+-- existing databases are migrated with migrations/sessions.sql
+create table sessions
+(
+    id         serial
+        constraint sessions_pk
+            primary key,
+    user_id    integer   not null
+        constraint sessions_users_id_fk
+            references users,
+    user_agent varchar,
+    created    timestamp not null,
+    last_used  timestamp not null,
+    revoked_at timestamp
+);
+
+create index sessions_user_id_index
+    on sessions (user_id);
+
+create table refresh_tokens
+(
+    token_hash varchar   not null
+        constraint refresh_tokens_pk
+            primary key,
+    session_id integer   not null
+        constraint refresh_tokens_sessions_id_fk
+            references sessions
+            on delete cascade,
+    created    timestamp not null,
+    expires_at timestamp not null,
+    rotated_at timestamp
+);
+
+create index refresh_tokens_session_id_index
+    on refresh_tokens (session_id);
+
+-- existing databases are migrated with migrations/email_changes.sql
+create table email_changes
+(
+    id           serial
+        constraint email_changes_pk
+            primary key,
+    user_id      integer   not null
+        constraint email_changes_users_id_fk
+            references users,
+    new_email    varchar   not null,
+    token_hash   varchar   not null,
+    created      timestamp not null,
+    expires_at   timestamp not null,
+    confirmed_at timestamp
+);
+
+create unique index email_changes_token_hash_uindex
+    on email_changes (token_hash);
+
+-- existing databases are migrated with migrations/email_verifications.sql
+create table email_verifications
+(
+    id         serial
+        constraint email_verifications_pk
+            primary key,
+    user_id    integer   not null
+        constraint email_verifications_users_id_fk
+            references users,
+    email      varchar   not null,
+    token_hash varchar   not null,
+    created    timestamp not null,
+    expires_at timestamp not null
+);
+
+create unique index email_verifications_token_hash_uindex
+    on email_verifications (token_hash);
+
+-- existing databases are migrated with migrations/user_versions.sql
+create table user_versions
+(
+    user_id  integer   not null
+        constraint user_versions_users_id_fk
+            references users,
+    version  integer   not null,
+    snapshot jsonb     not null,
+    created  timestamp not null,
+    constraint user_versions_pk
+        primary key (user_id, version)
+);
+
+-- existing databases are migrated with migrations/audit_log.sql
+create table audit_log
+(
+    id         serial
+        constraint audit_log_pk
+            primary key,
+    actor_id   integer,
+    actor_role varchar,
+    user_id    integer   not null,
+    operation  varchar   not null,
+    changes    jsonb     not null,
+    request_id varchar,
+    created    timestamp not null
+);
+
+create index audit_log_user_id_index
+    on audit_log (user_id);
+
+create function audit_log_append_only() returns trigger as
+$$
+begin
+    raise exception 'audit_log is append only';
+end;
+$$ language plpgsql;
+
+create trigger audit_log_append_only
+    before update or delete
+    on audit_log
+    for each row
+execute function audit_log_append_only();
 
 -- SCHEMA END
 
 -- INTEGRATION TEST DATA START
 
-INSERT INTO pronouns (subjective, objective)
-VALUES ('he', 'him'); -- ID = 1
+INSERT INTO pronouns (subjective, objective, curated)
+VALUES ('he', 'him', true); -- ID = 1
+
+INSERT INTO pronouns (subjective, objective, possessive, ask_me, curated)
+VALUES ('she', 'her', 'hers', false, true),   -- ID = 2
+       ('they', 'them', 'theirs', false, true), -- ID = 3
+       ('ask me', '', NULL, true, true);        -- ID = 4
 
-INSERT INTO users (email, phone_number, last_name, age, pronoun_id, first_name, role, oauth_uid,
-                   oauth_provider, years_of_experience, shirt_size, race, gender)
+INSERT INTO users (email, phone_number, last_name, age, pronoun_id, first_name, role, years_of_experience,
+                   shirt_size, race, gender)
 VALUES ('joe.bob@example.com'::varchar, '100-200-3000'::varchar, 'Bob'::varchar, 22::integer, 1::integer,
-        'Joe'::varchar, 'NORMAL'::varchar, '1'::varchar, 'GITHUB'::varchar, 3.5::double precision, 'L'::varchar,
-        ARRAY ['CAUCASIAN'], 'MALE'::varchar);
+        'Joe'::varchar, 'NORMAL'::varchar, 3.5::double precision, 'L'::varchar, ARRAY ['CAUCASIAN'], 'MALE'::varchar);
 -- ID = 1
 
+INSERT INTO oauth_identities (user_id, provider, uid, linked_at)
+VALUES (1, 'GITHUB', '1', '2022-11-09');
+
 INSERT INTO mlh_terms (user_id, send_messages, share_info, code_of_conduct)
 VALUES (1, true, true, true);
 
 INSERT INTO mailing_addresses (user_id, country, state, city, postal_code, address_lines)
 VALUES (1, 'United States', 'Florida', 'Orlando', '32765', ARRAY ['1000 Abc Rd', 'APT 69']);
 
-INSERT INTO users (email, phone_number, last_name, age, pronoun_id, first_name, role, oauth_uid,
-                   oauth_provider, years_of_experience, shirt_size, race, gender)
+INSERT INTO users (email, phone_number, last_name, age, pronoun_id, first_name, role, years_of_experience,
+                   shirt_size, race, gender)
 VALUES ('joe.biron@example.com'::varchar, '123-456-7890'::varchar, 'Biron'::varchar, 21::integer, 1::integer,
-        'Joe'::varchar, 'NORMAL'::varchar, '4'::varchar, 'GITHUB'::varchar, 3.5::double precision, 'L'::varchar,
-        ARRAY ['AFRICAN_AMERICAN'], 'MALE'::varchar);
+        'Joe'::varchar, 'NORMAL'::varchar, 3.5::double precision, 'L'::varchar, ARRAY ['AFRICAN_AMERICAN'],
+        'MALE'::varchar);
 -- ID = 2
 
-INSERT INTO api_keys (user_id, key, created)
-VALUES (2, '1234567890abc', '2022-11-09')
+INSERT INTO oauth_identities (user_id, provider, uid, linked_at)
+VALUES (2, 'GITHUB', '4', '2022-11-09');
+
+-- key = '1234567890abc'
+INSERT INTO api_keys (user_id, name, key_hash, scopes, created)
+VALUES (2, 'check-in scanner', '343c791deda10905e9c03bccaeb75413c9ee960af7b1f2291f4acc9925e2065a',
+        ARRAY ['CHECK_IN'], '2022-11-09');
 -- ID = 1
 
 
-INSERT INTO users (first_name, last_name, email, phone_number, age, pronoun_id, oauth_uid, oauth_provider, role,years_of_experience, shirt_size, race, gender, race) 
-    VALUES ("dough"::varchar, "boy"::varchar, "doughboy@gmail.com"::varchar, "4071234567"::varchar, 16::integer, 1::integer, 
-    "12velofabo12"::varchar, "GITHUB"::varchar, "ADMIN"::varchar, "2.5"::double precision, "Sm"::varchar, 
-    ARRAY ['AFRICAN_AMERICAN'], "MALE"::varchar,);
+INSERT INTO users (first_name, last_name, email, phone_number, age, pronoun_id, role, years_of_experience,
+                   shirt_size, race, gender)
+VALUES ('dough'::varchar, 'boy'::varchar, 'doughboy@gmail.com'::varchar, '4071234567'::varchar, 16::integer,
+        1::integer, 'ADMIN'::varchar, 2.5::double precision, 'S'::varchar, ARRAY ['AFRICAN_AMERICAN'],
+        'MALE'::varchar);
 --ID=3
 
+INSERT INTO oauth_identities (user_id, provider, uid, linked_at)
+VALUES (3, 'GITHUB', '12velofabo12', '2022-11-09');
+
 -- INTEGRATION TEST DATA END
\ No newline at end of file
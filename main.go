@@ -4,19 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/99designs/gqlgen/graphql"
 	"github.com/KnightHacks/knighthacks_shared/auth"
 	databaseUtils "github.com/KnightHacks/knighthacks_shared/database"
 	"github.com/KnightHacks/knighthacks_shared/pagination"
 	"github.com/KnightHacks/knighthacks_shared/utils"
+	"github.com/KnightHacks/knighthacks_users/email"
 	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/middleware"
+	"github.com/KnightHacks/knighthacks_users/oauth"
+	"github.com/KnightHacks/knighthacks_users/repository"
 	"github.com/KnightHacks/knighthacks_users/repository/database"
+	"github.com/KnightHacks/knighthacks_users/validation"
 	"github.com/gin-gonic/gin"
-	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/vektah/gqlparser/v2/gqlerror"
 	"log"
 	"os"
 	"runtime/debug"
+	"strings"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
@@ -24,7 +28,19 @@ import (
 	"github.com/KnightHacks/knighthacks_users/graph/generated"
 )
 
-const defaultPort = "8080"
+const (
+	defaultPort = "8080"
+	// defaultRestoreWindow is how long a deleted user can be restored for when RESTORE_WINDOW isn't set
+	defaultRestoreWindow = 30 * 24 * time.Hour
+	// purgeInterval is how often users past the restore window are erased
+	purgeInterval = time.Hour
+	// defaultEmailConfirmationURL is used when EMAIL_CONFIRMATION_URL isn't set
+	defaultEmailConfirmationURL = "http://localhost:3000/confirm-email"
+	// defaultEmailVerificationURL is used when EMAIL_VERIFICATION_URL isn't set
+	defaultEmailVerificationURL = "http://localhost:3000/verify-email"
+	// defaultAllowedRedirectOrigins is used when OAUTH_ALLOWED_REDIRECT_ORIGINS isn't set
+	defaultAllowedRedirectOrigins = "http://localhost:3000"
+)
 
 func main() {
 	log.SetFlags(log.Ldate | log.Ltime | log.Lmicroseconds | log.Llongfile)
@@ -42,17 +58,97 @@ func main() {
 	if err != nil {
 		log.Fatalf("An error occured when trying to create an instance of Auth: %s\n", err)
 	}
+
+	databaseRepository, err := database.NewDatabaseRepository(context.Background(), pool)
+	if err != nil {
+		log.Fatalf("error occured while initializing database repository err = %v\n", err)
+	}
+
+	restoreWindow := defaultRestoreWindow
+	if value, ok := os.LookupEnv("RESTORE_WINDOW"); ok {
+		restoreWindow, err = time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("RESTORE_WINDOW is not a valid duration: %v\n", err)
+		}
+	}
+	validationRules, err := validation.RulesFromEnvironment()
+	if err != nil {
+		log.Fatalf("invalid validation rules: %v\n", err)
+	}
+	oAuthProviders, err := oauth.RegistryFromEnvironment(newAuth)
+	if err != nil {
+		log.Fatalf("invalid oauth provider configuration: %v\n", err)
+	}
+	var oAuthStates oauth.StateStore = database.NewOAuthStateStore(pool)
+	if os.Getenv("OAUTH_STATE_STORE") == "memory" {
+		// only works when a single instance of the service is running
+		oAuthStates = oauth.NewMemoryStateStore()
+	}
+	// the origins users can be redirected back to after logging in are comma separated
+	allowedRedirectOrigins := strings.Split(defaultAllowedRedirectOrigins, ",")
+	if value := os.Getenv("OAUTH_ALLOWED_REDIRECT_ORIGINS"); value != "" {
+		allowedRedirectOrigins = strings.Split(value, ",")
+	}
+	emailSender, err := email.SenderFromEnvironment()
+	if err != nil {
+		log.Fatalf("unable to create email sender: %v\n", err)
+	}
+	emailConfirmationURL := os.Getenv("EMAIL_CONFIRMATION_URL")
+	if emailConfirmationURL == "" {
+		emailConfirmationURL = defaultEmailConfirmationURL
+	}
+	emailVerificationURL := os.Getenv("EMAIL_VERIFICATION_URL")
+	if emailVerificationURL == "" {
+		emailVerificationURL = defaultEmailVerificationURL
+	}
+	go purgeDeletedUsers(context.Background(), databaseRepository, restoreWindow)
+
 	ginRouter := gin.Default()
+	ginRouter.Use(middleware.RequestIDMiddleware())
+	// the api key middleware must come before the jwt middleware
+	ginRouter.Use(middleware.APIKeyAuthMiddleware(databaseRepository))
 	ginRouter.Use(auth.AuthContextMiddleware(newAuth))
+	ginRouter.Use(middleware.ActorMiddleware())
 	ginRouter.Use(utils.GinContextMiddleware())
 
-	ginRouter.POST("/query", graphqlHandler(newAuth, pool))
+	ginRouter.POST("/query", graphqlHandler(&graph.Resolver{
+		Repository:             databaseRepository,
+		Auth:                   newAuth,
+		OAuthProviders:         oAuthProviders,
+		OAuthStates:            oAuthStates,
+		AllowedRedirectOrigins: allowedRedirectOrigins,
+		RestoreWindow:          restoreWindow,
+		ValidationRules:        validationRules,
+		EmailSender:            emailSender,
+		EmailConfirmationURL:   emailConfirmationURL,
+		EmailVerificationURL:   emailVerificationURL,
+	}))
 	ginRouter.GET("/", playgroundHandler())
 
 	log.Fatalln(ginRouter.Run(":" + port))
 }
 
-func graphqlHandler(a *auth.Auth, pool *pgxpool.Pool) gin.HandlerFunc {
+// purgeDeletedUsers erases every user that was deleted longer than the restore window ago,
+// this runs forever so it should be started in its own goroutine
+func purgeDeletedUsers(ctx context.Context, repository repository.Repository, restoreWindow time.Duration) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+	for {
+		purged, err := repository.PurgeDeletedUsers(ctx, time.Now().UTC().Add(-restoreWindow))
+		if err != nil {
+			log.Printf("unable to purge deleted users err = %v\n", err)
+		} else if purged > 0 {
+			log.Printf("purged %d deleted users\n", purged)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func graphqlHandler(resolver *graph.Resolver) gin.HandlerFunc {
 	hasRoleDirective := auth.HasRoleDirective{GetUserId: func(ctx context.Context, obj interface{}) (string, error) {
 		switch t := obj.(type) {
 		case *model.User:
@@ -62,34 +158,25 @@ func graphqlHandler(a *auth.Auth, pool *pgxpool.Pool) gin.HandlerFunc {
 			return "", errors.New("this shouldn't happen")
 		}
 	}}
-
-	repository, err := database.NewDatabaseRepository(context.Background(), pool)
-	if err != nil {
-		log.Fatalf("error occured while initializing database repository err = %v\n", err)
-	}
 	config := generated.Config{
-		Resolvers: &graph.Resolver{
-			Repository: repository,
-			Auth:       a,
-		},
+		Resolvers: resolver,
 		Directives: generated.DirectiveRoot{
-			HasRole:    hasRoleDirective.Direct,
-			Pagination: pagination.Pagination,
+			HasRole:       graph.HasRoleErrors(middleware.APIKeyScopes(hasRoleDirective.Direct)),
+			Pagination:    pagination.Pagination,
+			EmailVerified: resolver.EmailVerifiedDirective,
 		},
 	}
 	srv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
+	srv.AroundOperations(middleware.APIKeyOperationMiddleware)
+	srv.AroundOperations(resolver.LoadersMiddleware)
 	srv.SetRecoverFunc(func(ctx context.Context, iErr interface{}) error {
 		err := fmt.Errorf("%v", iErr)
 
 		log.Printf("runtime error: %v\n\n%v\n", err, string(debug.Stack()))
 
-		return gqlerror.Errorf("Internal server error! Check logs for more details!")
-	})
-	srv.SetErrorPresenter(func(ctx context.Context, err error) *gqlerror.Error {
-		log.Printf("Error presented: %v\n", err)
-		debug.PrintStack()
-		return graphql.DefaultErrorPresenter(ctx, err)
+		return graph.NewError(graph.ErrorCodeInternal, "Internal server error! Check logs for more details!")
 	})
+	srv.SetErrorPresenter(graph.ErrorPresenter)
 	return func(c *gin.Context) {
 		srv.ServeHTTP(c.Writer, c.Request)
 	}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/KnightHacks/knighthacks_shared/auth"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// ActorMiddleware stores who is making the request and the request's id for the repository
+// to record in the audit log. It must come after RequestIDMiddleware and the auth middlewares.
+func ActorMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		actor := repository.Actor{RequestID: RequestIDFromContext(ctx)}
+		if claims, ok := ctx.Value(UserClaimsContextKey).(*auth.UserClaims); ok && claims != nil {
+			actor.UserID = claims.UserID
+			actor.Role = claims.Role
+		}
+		c.Request = c.Request.WithContext(repository.WithActor(ctx, actor))
+		c.Next()
+	}
+}
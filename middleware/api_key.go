@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/KnightHacks/knighthacks_shared/auth"
+	"github.com/KnightHacks/knighthacks_shared/models"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/gin-gonic/gin"
+	"github.com/vektah/gqlparser/v2/ast"
+	"log"
+	"net/http"
+	"strings"
+)
+
+const (
+	APIKeyHeader = "X-API-Key"
+	// APIKeyAuthorizationScheme is the scheme used when the key is sent in the Authorization header,
+	// Authorization: ApiKey <key>
+	APIKeyAuthorizationScheme = "ApiKey"
+
+	// UserClaimsContextKey is the same key the shared auth middleware stores the JWT claims under,
+	// the @hasRole directive reads from this key
+	UserClaimsContextKey = "AuthorizationUserClaims"
+	APIKeyContextKey     = "AuthorizationAPIKey"
+)
+
+// APIKeyAuthMiddleware authenticates machine clients using an API key sent in either the
+// X-API-Key header or the Authorization header with the ApiKey scheme.
+//
+// The key owner's claims are stored in the context exactly like the JWT middleware would,
+// so the @hasRole directive and the ownership checks in the resolvers work unchanged. This
+// must be installed before auth.AuthContextMiddleware so the JWT middleware never sees the
+// ApiKey Authorization header.
+func APIKeyAuthMiddleware(repo repository.Repository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key, ok := apiKeyFromRequest(c.Request)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		user, apiKey, err := repo.GetUserByAPIKey(c.Request.Context(), key)
+		if err != nil {
+			if !errors.Is(err, repository.APIKeyNotFound) {
+				log.Printf("unable to authenticate api key, err = %v\n", err)
+			}
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid api key"})
+			return
+		}
+		c.Request.Header.Del("Authorization")
+
+		claims := &auth.UserClaims{
+			UserID: user.ID,
+			Role:   CapRole(user.Role, apiKey.Scopes),
+		}
+		ctx := context.WithValue(c.Request.Context(), UserClaimsContextKey, claims)
+		ctx = context.WithValue(ctx, APIKeyContextKey, apiKey)
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+func apiKeyFromRequest(r *http.Request) (string, bool) {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return key, true
+	}
+	scheme, key, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if found && strings.EqualFold(scheme, APIKeyAuthorizationScheme) && key != "" {
+		return strings.TrimSpace(key), true
+	}
+	return "", false
+}
+
+// CapRole returns the role an API key is allowed to act with. A key can never act with
+// more than its owner's role, and only keys with the ADMIN_EXPORT scope keep the ADMIN role.
+func CapRole(ownerRole models.Role, scopes []model.APIKeyScope) models.Role {
+	if ownerRole == models.RoleAdmin {
+		for _, scope := range scopes {
+			if scope == model.APIKeyScopeAdminExport {
+				return models.RoleAdmin
+			}
+		}
+	}
+	return models.RoleNormal
+}
+
+// APIKeyScopes wraps the hasRole directive so API keys can only read the fields that are
+// restricted to the owner if they have the READ_PROFILE scope, or are ADMIN_EXPORT keys of an
+// admin. CHECK_IN keys can only read what every logged in user can, which is all a scanner needs.
+func APIKeyScopes(hasRole func(ctx context.Context, obj interface{}, next graphql.Resolver, role models.Role) (interface{}, error)) func(ctx context.Context, obj interface{}, next graphql.Resolver, role models.Role) (interface{}, error) {
+	return func(ctx context.Context, obj interface{}, next graphql.Resolver, role models.Role) (interface{}, error) {
+		apiKey := APIKeyFromContext(ctx)
+		if role != models.RoleOwns || apiKey == nil || hasScope(apiKey.Scopes, model.APIKeyScopeReadProfile) {
+			return hasRole(ctx, obj, next, role)
+		}
+		// CapRole only keeps the ADMIN role for ADMIN_EXPORT keys
+		if claims, ok := ctx.Value(UserClaimsContextKey).(*auth.UserClaims); ok && claims.Role == models.RoleAdmin {
+			return hasRole(ctx, obj, next, role)
+		}
+		return nil, errors.New("the api key does not have the READ_PROFILE scope")
+	}
+}
+
+func hasScope(scopes []model.APIKeyScope, scope model.APIKeyScope) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKeyFromContext returns the API key the request was authenticated with, nil if the
+// request was not authenticated using an API key
+func APIKeyFromContext(ctx context.Context) *model.APIKey {
+	apiKey, _ := ctx.Value(APIKeyContextKey).(*model.APIKey)
+	return apiKey
+}
+
+// APIKeyOperationMiddleware rejects mutations made with an API key, none of the API key
+// scopes allow changing data in this service
+func APIKeyOperationMiddleware(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	operationContext := graphql.GetOperationContext(ctx)
+	if APIKeyFromContext(ctx) != nil && operationContext.Operation != nil && operationContext.Operation.Operation != ast.Query {
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "api keys may only be used for queries"))
+	}
+	return next(ctx)
+}
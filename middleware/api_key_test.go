@@ -0,0 +1,257 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/KnightHacks/knighthacks_shared/auth"
+	"github.com/KnightHacks/knighthacks_shared/models"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/gin-gonic/gin"
+	"github.com/vektah/gqlparser/v2/ast"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// apiKeyRepository only implements GetUserByAPIKey, calling anything else panics
+type apiKeyRepository struct {
+	repository.Repository
+	keys map[string]*model.APIKey
+	// owners maps the key to the role of the user that owns it
+	owners map[string]models.Role
+}
+
+func (r *apiKeyRepository) GetUserByAPIKey(ctx context.Context, key string) (*model.User, *model.APIKey, error) {
+	apiKey, ok := r.keys[key]
+	if !ok {
+		return nil, nil, repository.APIKeyNotFound
+	}
+	return &model.User{ID: "1", Role: r.owners[key]}, apiKey, nil
+}
+
+func newAPIKeyRepository() *apiKeyRepository {
+	return &apiKeyRepository{
+		keys: map[string]*model.APIKey{
+			"read":         {ID: "1", Scopes: []model.APIKeyScope{model.APIKeyScopeReadProfile}},
+			"check-in":     {ID: "2", Scopes: []model.APIKeyScope{model.APIKeyScopeCheckIn}},
+			"admin-export": {ID: "3", Scopes: []model.APIKeyScope{model.APIKeyScopeAdminExport}},
+			"normal-admin": {ID: "4", Scopes: []model.APIKeyScope{model.APIKeyScopeAdminExport}},
+		},
+		owners: map[string]models.Role{
+			"read":         models.RoleAdmin,
+			"check-in":     models.RoleNormal,
+			"admin-export": models.RoleAdmin,
+			"normal-admin": models.RoleNormal,
+		},
+	}
+}
+
+// requestResult is what the test route saw of the request
+type requestResult struct {
+	UserID        string `json:"userId"`
+	Role          string `json:"role"`
+	APIKeyID      string `json:"apiKeyId"`
+	Authorization string `json:"authorization"`
+	Resolved      bool   `json:"resolved"`
+	Error         string `json:"error"`
+}
+
+// serve sends the request through APIKeyAuthMiddleware to a route that runs handle with the
+// request's context
+func serve(t *testing.T, request *http.Request, handle func(ctx context.Context, result *requestResult)) (int, *requestResult) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(APIKeyAuthMiddleware(newAPIKeyRepository()))
+	router.Any("/", func(c *gin.Context) {
+		ctx := c.Request.Context()
+		result := &requestResult{Authorization: c.GetHeader("Authorization")}
+		if claims, ok := ctx.Value(UserClaimsContextKey).(*auth.UserClaims); ok {
+			result.UserID = claims.UserID
+			result.Role = claims.Role.String()
+		}
+		if apiKey := APIKeyFromContext(ctx); apiKey != nil {
+			result.APIKeyID = apiKey.ID
+		}
+		if handle != nil {
+			handle(ctx, result)
+		}
+		c.JSON(http.StatusOK, result)
+	})
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	var result requestResult
+	if recorder.Code == http.StatusOK {
+		if err := json.Unmarshal(recorder.Body.Bytes(), &result); err != nil {
+			t.Fatalf("unable to read the response: %v", err)
+		}
+	}
+	return recorder.Code, &result
+}
+
+func TestAPIKeyAuthMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		value    string
+		wantCode int
+		want     requestResult
+	}{
+		{
+			name:     "no key",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "bearer token is left for the JWT middleware",
+			header:   "Authorization",
+			value:    "Bearer abc",
+			wantCode: http.StatusOK,
+			want:     requestResult{Authorization: "Bearer abc"},
+		},
+		{
+			name:     "X-API-Key header",
+			header:   APIKeyHeader,
+			value:    "check-in",
+			wantCode: http.StatusOK,
+			want:     requestResult{UserID: "1", Role: "NORMAL", APIKeyID: "2"},
+		},
+		{
+			name:     "ApiKey authorization scheme",
+			header:   "Authorization",
+			value:    "apikey admin-export",
+			wantCode: http.StatusOK,
+			want:     requestResult{UserID: "1", Role: "ADMIN", APIKeyID: "3"},
+		},
+		{
+			name:     "admin's key without ADMIN_EXPORT is capped",
+			header:   APIKeyHeader,
+			value:    "read",
+			wantCode: http.StatusOK,
+			want:     requestResult{UserID: "1", Role: "NORMAL", APIKeyID: "1"},
+		},
+		{
+			name:     "unknown key",
+			header:   APIKeyHeader,
+			value:    "nope",
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tt.header != "" {
+				request.Header.Set(tt.header, tt.value)
+			}
+			code, result := serve(t, request, nil)
+			if code != tt.wantCode {
+				t.Fatalf("status = %d, want %d", code, tt.wantCode)
+			}
+			if code == http.StatusOK && *result != tt.want {
+				t.Errorf("request = %+v, want %+v", *result, tt.want)
+			}
+		})
+	}
+}
+
+func TestCapRole(t *testing.T) {
+	tests := []struct {
+		name      string
+		ownerRole models.Role
+		scopes    []model.APIKeyScope
+		want      models.Role
+	}{
+		{"admin with ADMIN_EXPORT", models.RoleAdmin, []model.APIKeyScope{model.APIKeyScopeCheckIn, model.APIKeyScopeAdminExport}, models.RoleAdmin},
+		{"admin without ADMIN_EXPORT", models.RoleAdmin, []model.APIKeyScope{model.APIKeyScopeReadProfile}, models.RoleNormal},
+		{"normal user with ADMIN_EXPORT", models.RoleNormal, []model.APIKeyScope{model.APIKeyScopeAdminExport}, models.RoleNormal},
+		{"sponsor", models.RoleSponsor, []model.APIKeyScope{model.APIKeyScopeReadProfile}, models.RoleNormal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CapRole(tt.ownerRole, tt.scopes); got != tt.want {
+				t.Errorf("CapRole() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIKeyOperationMiddleware(t *testing.T) {
+	tests := []struct {
+		name         string
+		apiKey       string
+		operation    ast.Operation
+		wantResolved bool
+	}{
+		{"query with a key", "read", ast.Query, true},
+		{"mutation with a key", "admin-export", ast.Mutation, false},
+		{"mutation without a key", "", ast.Mutation, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tt.apiKey != "" {
+				request.Header.Set(APIKeyHeader, tt.apiKey)
+			}
+			_, result := serve(t, request, func(ctx context.Context, result *requestResult) {
+				ctx = graphql.WithOperationContext(ctx, &graphql.OperationContext{
+					Operation: &ast.OperationDefinition{Operation: tt.operation},
+				})
+				response := APIKeyOperationMiddleware(ctx, func(ctx context.Context) graphql.ResponseHandler {
+					result.Resolved = true
+					return graphql.OneShot(&graphql.Response{})
+				})(ctx)
+				if len(response.Errors) > 0 {
+					result.Error = response.Errors[0].Message
+				}
+			})
+			if result.Resolved != tt.wantResolved {
+				t.Errorf("operation resolved = %v, want %v (error = %q)", result.Resolved, tt.wantResolved, result.Error)
+			}
+			if !tt.wantResolved && result.Error == "" {
+				t.Errorf("operation was rejected without an error")
+			}
+		})
+	}
+}
+
+func TestAPIKeyScopes(t *testing.T) {
+	// hasRole lets everything through, only what APIKeyScopes rejects is checked
+	hasRole := APIKeyScopes(func(ctx context.Context, obj interface{}, next graphql.Resolver, role models.Role) (interface{}, error) {
+		return next(ctx)
+	})
+	tests := []struct {
+		name         string
+		apiKey       string
+		role         models.Role
+		wantResolved bool
+	}{
+		{"READ_PROFILE reads owner-only fields", "read", models.RoleOwns, true},
+		{"CHECK_IN can't read owner-only fields", "check-in", models.RoleOwns, false},
+		{"CHECK_IN reads fields every user can", "check-in", models.RoleNormal, true},
+		{"admin's ADMIN_EXPORT reads owner-only fields", "admin-export", models.RoleOwns, true},
+		{"normal user's ADMIN_EXPORT can't read owner-only fields", "normal-admin", models.RoleOwns, false},
+		{"no key is left to hasRole", "", models.RoleOwns, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tt.apiKey != "" {
+				request.Header.Set(APIKeyHeader, tt.apiKey)
+			}
+			_, result := serve(t, request, func(ctx context.Context, result *requestResult) {
+				_, err := hasRole(ctx, &model.User{ID: "1"}, func(ctx context.Context) (interface{}, error) {
+					result.Resolved = true
+					return nil, nil
+				}, tt.role)
+				if err != nil {
+					result.Error = err.Error()
+				}
+			})
+			if result.Resolved != tt.wantResolved {
+				t.Errorf("field resolved = %v, want %v (error = %q)", result.Resolved, tt.wantResolved, result.Error)
+			}
+		})
+	}
+}
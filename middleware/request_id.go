@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+	// RequestIDContextKey is the key the request's id is stored under, it is recorded in the audit log
+	RequestIDContextKey = "RequestID"
+	// maxRequestIDLength stops clients from filling the audit log with huge request ids
+	maxRequestIDLength = 128
+)
+
+// RequestIDMiddleware gives every request an id, the X-Request-ID header is used if the
+// gateway already set one. The id is echoed back in the response's X-Request-ID header.
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestId := c.GetHeader(RequestIDHeader)
+		if requestId == "" || len(requestId) > maxRequestIDLength {
+			b := make([]byte, 16)
+			if _, err := rand.Read(b); err != nil {
+				c.AbortWithError(http.StatusInternalServerError, err)
+				return
+			}
+			requestId = hex.EncodeToString(b)
+		}
+		c.Header(RequestIDHeader, requestId)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), RequestIDContextKey, requestId))
+		c.Next()
+	}
+}
+
+// RequestIDFromContext returns the id of the request, an empty string if there is no request
+func RequestIDFromContext(ctx context.Context) string {
+	requestId, _ := ctx.Value(RequestIDContextKey).(string)
+	return requestId
+}
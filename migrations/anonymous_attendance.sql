@@ -0,0 +1,21 @@
+-- Adds the tables erased users' check-ins and event attendance are kept in to a database created
+-- before users could be erased, see init.sql for the full schema.
+begin;
+
+create table if not exists anonymous_hackathon_checkin
+(
+    hackathon_id integer   not null
+        constraint anonymous_hackathon_checkin_hackathons_id_fk
+            references hackathons,
+    time         timestamp not null
+);
+
+create table if not exists anonymous_event_attendance
+(
+    event_id integer   not null
+        constraint anonymous_event_attendance_events_id_fk
+            references events,
+    time     timestamp not null
+);
+
+commit;
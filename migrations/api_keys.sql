@@ -0,0 +1,56 @@
+-- Hashes and scopes the API keys of a database created when users had a single plaintext key, see
+-- init.sql for the full schema. Existing keys keep working, only their sha256 hash is kept and
+-- they are given the READ_PROFILE scope, they were used to read their owner's profile before.
+begin;
+
+-- users can have more than one key so the key is identified by its own id instead of the user's
+alter table api_keys
+    drop constraint if exists api_keys_pk;
+
+alter table api_keys
+    add column if not exists id serial;
+
+alter table api_keys
+    add constraint api_keys_pk primary key (id);
+
+alter table api_keys
+    add column if not exists name varchar,
+    add column if not exists key_hash varchar,
+    add column if not exists scopes character varying[],
+    add column if not exists expires_at timestamp,
+    add column if not exists last_used_at timestamp;
+
+do
+$$
+    begin
+        if exists (select
+                   from information_schema.columns
+                   where table_name = 'api_keys'
+                     and column_name = 'key') then
+            -- the same hash as HashToken in tokens.go
+            update api_keys
+            set key_hash = encode(sha256(convert_to(key, 'UTF8')), 'hex'),
+                name     = 'API key',
+                scopes   = array ['READ_PROFILE']
+            where key_hash is null;
+        end if;
+    end
+$$;
+
+alter table api_keys
+    alter column name set not null,
+    alter column key_hash set not null,
+    alter column scopes set not null;
+
+drop index if exists api_keys_key_uindex;
+
+alter table api_keys
+    drop column if exists key;
+
+create unique index if not exists api_keys_key_hash_uindex
+    on api_keys (key_hash);
+
+create index if not exists api_keys_user_id_index
+    on api_keys (user_id);
+
+commit;
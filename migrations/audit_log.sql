@@ -0,0 +1,38 @@
+-- Adds the audit log to a database created before changes to users were recorded, see init.sql for
+-- the full schema. Changes made before this have no entries. Rows can't be updated or deleted once
+-- they are written, the trigger is replaced so the migration can be run again.
+begin;
+
+create table if not exists audit_log
+(
+    id         serial
+        constraint audit_log_pk
+            primary key,
+    actor_id   integer,
+    actor_role varchar,
+    user_id    integer   not null,
+    operation  varchar   not null,
+    changes    jsonb     not null,
+    request_id varchar,
+    created    timestamp not null
+);
+
+create index if not exists audit_log_user_id_index
+    on audit_log (user_id);
+
+create or replace function audit_log_append_only() returns trigger as
+$$
+begin
+    raise exception 'audit_log is append only';
+end;
+$$ language plpgsql;
+
+drop trigger if exists audit_log_append_only on audit_log;
+
+create trigger audit_log_append_only
+    before update or delete
+    on audit_log
+    for each row
+execute function audit_log_append_only();
+
+commit;
@@ -0,0 +1,12 @@
+-- Indexes the expressions duplicate users are found by, see init.sql for the full schema. The
+-- indexes are built concurrently so this must not be run inside a transaction.
+create extension if not exists pg_trgm;
+
+create index concurrently if not exists users_duplicate_email_index
+    on users (regexp_replace(lower(trim(email)), '\+[^@]*@', '@'));
+
+create index concurrently if not exists users_duplicate_phone_number_index
+    on users (nullif(right(regexp_replace(coalesce(phone_number, ''), '[^0-9]', '', 'g'), 10), ''));
+
+create index concurrently if not exists users_duplicate_name_index
+    on users using gin (lower(first_name || ' ' || last_name) gin_trgm_ops);
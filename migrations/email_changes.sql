@@ -0,0 +1,23 @@
+-- Adds the pending email changes to a database created before users had to confirm their new
+-- email, see init.sql for the full schema. Only the hash of each confirmation token is stored.
+begin;
+
+create table if not exists email_changes
+(
+    id           serial
+        constraint email_changes_pk
+            primary key,
+    user_id      integer   not null
+        constraint email_changes_users_id_fk
+            references users,
+    new_email    varchar   not null,
+    token_hash   varchar   not null,
+    created      timestamp not null,
+    expires_at   timestamp not null,
+    confirmed_at timestamp
+);
+
+create unique index if not exists email_changes_token_hash_uindex
+    on email_changes (token_hash);
+
+commit;
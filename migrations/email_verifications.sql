@@ -0,0 +1,23 @@
+-- Adds the pending email verifications to a database created before emails were verified, see
+-- init.sql for the full schema. Only the hash of each verification token is stored. This must be
+-- run with migrations/email_verified.sql, which adds users.email_verified.
+begin;
+
+create table if not exists email_verifications
+(
+    id         serial
+        constraint email_verifications_pk
+            primary key,
+    user_id    integer   not null
+        constraint email_verifications_users_id_fk
+            references users,
+    email      varchar   not null,
+    token_hash varchar   not null,
+    created    timestamp not null,
+    expires_at timestamp not null
+);
+
+create unique index if not exists email_verifications_token_hash_uindex
+    on email_verifications (token_hash);
+
+commit;
@@ -0,0 +1,13 @@
+-- Adds users.email_verified to a database created before emails were verified, see init.sql for
+-- the full schema. Users that registered before this change were never sent a verification link,
+-- so they are marked as verified instead of being locked out of linkProvider and addAPIKey.
+begin;
+
+alter table users
+    add column if not exists email_verified boolean default true not null;
+
+-- only users that register from now on start out unverified
+alter table users
+    alter column email_verified set default false;
+
+commit;
@@ -0,0 +1,32 @@
+-- Stores emails lowercased and makes them unique case-insensitively, see init.sql for the full
+-- schema. Users whose emails only differ by case are the same person and must be merged with the
+-- mergeUsers mutation first, the migration lists them and changes nothing until they are.
+begin;
+
+do
+$$
+    declare
+        duplicates text;
+    begin
+        select string_agg(format('%s: users %s', email, user_ids), E'\n')
+        into duplicates
+        from (select lower(trim(email)) as email, string_agg(id::text, ', ' order by id) as user_ids
+              from users
+              group by lower(trim(email))
+              having count(*) > 1) as duplicate_emails;
+        if duplicates is not null then
+            raise exception E'merge the users that share an email before running this migration:\n%', duplicates;
+        end if;
+    end
+$$;
+
+update users
+set email = lower(trim(email))
+where email <> lower(trim(email));
+
+drop index if exists users_email_uindex;
+
+create unique index users_email_uindex
+    on users (lower(email));
+
+commit;
@@ -0,0 +1,43 @@
+-- Moves the OAuth identity of every user into oauth_identities in a database created when users
+-- had a single identity, see init.sql for the full schema. Users keep logging in with the identity
+-- they registered with, it is copied over before users.oauth_uid and users.oauth_provider are
+-- dropped. This must be run before migrations/oauth_identities_deleted_at.sql.
+begin;
+
+create table if not exists oauth_identities
+(
+    user_id   integer   not null
+        constraint oauth_identities_users_id_fk
+            references users
+            on delete cascade,
+    provider  varchar   not null,
+    uid       varchar   not null,
+    linked_at timestamp not null,
+    constraint oauth_identities_pk
+        primary key (provider, uid)
+);
+
+create unique index if not exists oauth_identities_user_id_provider_uindex
+    on oauth_identities (user_id, provider);
+
+do
+$$
+    begin
+        if exists (select
+                   from information_schema.columns
+                   where table_name = 'users'
+                     and column_name = 'oauth_uid') then
+            -- when users linked their identity isn't known, they have had it since before this
+            insert into oauth_identities (user_id, provider, uid, linked_at)
+            select id, oauth_provider, oauth_uid, timezone('utc', now())
+            from users
+            on conflict do nothing;
+        end if;
+    end
+$$;
+
+alter table users
+    drop column if exists oauth_uid,
+    drop column if exists oauth_provider;
+
+commit;
@@ -0,0 +1,27 @@
+-- Lets the OAuth identities of soft deleted users register another user, see init.sql for the
+-- full schema. An identity is still linked to a single user that isn't deleted. This must be run
+-- after migrations/oauth_identities.sql and migrations/users_deleted_at.sql.
+begin;
+
+alter table oauth_identities
+    add column if not exists deleted_at timestamp;
+
+update oauth_identities
+set deleted_at = users.deleted_at
+from users
+where users.id = oauth_identities.user_id
+  and users.deleted_at is not null;
+
+alter table oauth_identities
+    drop constraint oauth_identities_pk;
+
+alter table oauth_identities
+    add constraint oauth_identities_pk primary key (user_id, provider);
+
+drop index if exists oauth_identities_user_id_provider_uindex;
+
+create unique index if not exists oauth_identities_provider_uid_uindex
+    on oauth_identities (provider, uid)
+    where deleted_at is null;
+
+commit;
@@ -0,0 +1,17 @@
+-- Adds the pending OAuth logins to a database created before their state was checked when the
+-- user came back from their provider, see init.sql for the full schema. Only the hash of each
+-- state is stored, logins started before this have to be started again.
+begin;
+
+create table if not exists oauth_states
+(
+    state_hash    varchar   not null
+        constraint oauth_states_pk
+            primary key,
+    provider      varchar   not null,
+    code_verifier varchar   not null,
+    redirect_url  varchar,
+    expires_at    timestamp not null
+);
+
+commit;
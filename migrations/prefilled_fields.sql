@@ -0,0 +1,9 @@
+-- Adds users.prefilled_fields to a database created before registration was prefilled from the
+-- OAuth provider's profile, see init.sql for the full schema. Existing users registered without a
+-- prefilled form, so they start out with none.
+begin;
+
+alter table users
+    add column if not exists prefilled_fields varchar[] default '{}' not null;
+
+commit;
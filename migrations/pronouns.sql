@@ -0,0 +1,78 @@
+-- Adds the pronoun catalog, makes pronouns unique and users.pronoun_id a foreign key in a database
+-- created before, see init.sql for the full schema. Pronouns that only differ by case or whitespace
+-- are merged into the oldest of them, the same one GetOrCreatePronoun matched. What users see
+-- doesn't change so they aren't given a new version. This must be run before
+-- migrations/user_versions.sql.
+begin;
+
+-- existing pronouns were typed in by users, none of them are offered until they are curated
+alter table pronouns
+    add column if not exists possessive varchar,
+    add column if not exists ask_me     boolean default false not null,
+    add column if not exists curated    boolean default false not null,
+    add column if not exists retired_at timestamp;
+
+create temporary table merged_pronouns on commit drop as
+select id,
+       min(id) over (partition by lower(btrim(regexp_replace(coalesce(subjective, ''), '\s+', ' ', 'g'))),
+           lower(btrim(regexp_replace(coalesce(objective, ''), '\s+', ' ', 'g'))),
+           lower(btrim(regexp_replace(coalesce(possessive, ''), '\s+', ' ', 'g'))),
+           ask_me) as keep_id,
+       curated,
+       retired_at
+from pronouns;
+
+-- the kept pronouns are offered if any of the merged ones were, the same as MergePronouns
+update pronouns
+set curated    = pronouns.curated or merged.curated,
+    retired_at = case when merged.offered then null else pronouns.retired_at end
+from (select keep_id, bool_or(curated) as curated, bool_or(curated and retired_at is null) as offered
+      from merged_pronouns
+      where id <> keep_id
+      group by keep_id) as merged
+where merged.keep_id = pronouns.id;
+
+update users
+set pronoun_id = merged.keep_id
+from merged_pronouns merged
+where users.pronoun_id = merged.id
+  and merged.id <> merged.keep_id;
+
+delete
+from pronouns
+using merged_pronouns merged
+where pronouns.id = merged.id
+  and merged.id <> merged.keep_id;
+
+create unique index if not exists pronouns_normalized_uindex
+    on pronouns (lower(btrim(regexp_replace(coalesce(subjective, ''), '\s+', ' ', 'g'))),
+                 lower(btrim(regexp_replace(coalesce(objective, ''), '\s+', ' ', 'g'))),
+                 lower(btrim(regexp_replace(coalesce(possessive, ''), '\s+', ' ', 'g'))),
+                 ask_me);
+
+-- ids of pronouns that were deleted can't be read anyway
+update users
+set pronoun_id = null
+where pronoun_id is not null
+  and not exists(select 1 from pronouns where pronouns.id = users.pronoun_id);
+
+alter table users
+    drop constraint if exists users_pronouns_id_fk,
+    add constraint users_pronouns_id_fk foreign key (pronoun_id) references pronouns;
+
+-- the options offered by pronounOptions, matching pronouns are offered instead of adding new ones
+-- the same as addPronounOption. Pronouns stored without a possessive don't match, admins can merge
+-- them into the options with mergePronouns.
+insert into pronouns (subjective, objective, possessive, ask_me, curated)
+values ('he', 'him', 'his', false, true),
+       ('she', 'her', 'hers', false, true),
+       ('they', 'them', 'theirs', false, true),
+       ('ask me', '', null, true, true)
+on conflict (lower(btrim(regexp_replace(coalesce(subjective, ''), '\s+', ' ', 'g'))),
+    lower(btrim(regexp_replace(coalesce(objective, ''), '\s+', ' ', 'g'))),
+    lower(btrim(regexp_replace(coalesce(possessive, ''), '\s+', ' ', 'g'))),
+    ask_me) do update
+    set curated    = true,
+        retired_at = null;
+
+commit;
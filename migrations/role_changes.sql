@@ -0,0 +1,25 @@
+-- Adds the role change audit trail to a database created before roles could be changed with
+-- setUserRole, see init.sql for the full schema. Role changes made before this have no record.
+begin;
+
+create table if not exists role_changes
+(
+    id            serial
+        constraint role_changes_pk
+            primary key,
+    user_id       integer   not null
+        constraint role_changes_users_id_fk
+            references users,
+    previous_role varchar   not null,
+    role          varchar   not null,
+    changed_by    integer
+        constraint role_changes_users_changed_by_fk
+            references users
+            on delete set null,
+    changed_at    timestamp not null
+);
+
+create index if not exists role_changes_user_id_index
+    on role_changes (user_id);
+
+commit;
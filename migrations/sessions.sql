@@ -0,0 +1,40 @@
+-- Adds refresh token sessions to a database created before they were stored, see init.sql for the
+-- full schema. Refresh tokens issued before this aren't in refresh_tokens so they can't be
+-- rotated, their users have to log in again once their access token expires.
+begin;
+
+create table if not exists sessions
+(
+    id         serial
+        constraint sessions_pk
+            primary key,
+    user_id    integer   not null
+        constraint sessions_users_id_fk
+            references users,
+    user_agent varchar,
+    created    timestamp not null,
+    last_used  timestamp not null,
+    revoked_at timestamp
+);
+
+create index if not exists sessions_user_id_index
+    on sessions (user_id);
+
+create table if not exists refresh_tokens
+(
+    token_hash varchar   not null
+        constraint refresh_tokens_pk
+            primary key,
+    session_id integer   not null
+        constraint refresh_tokens_sessions_id_fk
+            references sessions
+            on delete cascade,
+    created    timestamp not null,
+    expires_at timestamp not null,
+    rotated_at timestamp
+);
+
+create index if not exists refresh_tokens_session_id_index
+    on refresh_tokens (session_id);
+
+commit;
@@ -0,0 +1,75 @@
+-- Adds user versions to a database created before users were versioned, see init.sql for the
+-- full schema. Every existing user is given their first version, a snapshot of how they are when
+-- this is run, so userAsOf finds them before their next change. How they were before that isn't
+-- known, userAsOf returns null for earlier times. The snapshot must match model.UserSnapshot.
+-- This must be run after migrations/pronouns.sql, which adds the columns the snapshot reads.
+begin;
+
+alter table users
+    add column if not exists version integer default 1 not null;
+
+create table if not exists user_versions
+(
+    user_id  integer   not null
+        constraint user_versions_users_id_fk
+            references users,
+    version  integer   not null,
+    snapshot jsonb     not null,
+    created  timestamp not null,
+    constraint user_versions_pk
+        primary key (user_id, version)
+);
+
+insert into user_versions (user_id, version, snapshot, created)
+select users.id,
+       users.version,
+       jsonb_strip_nulls(jsonb_build_object(
+               'id', users.id::text,
+               'firstName', users.first_name,
+               'lastName', users.last_name,
+               'email', users.email,
+               'phoneNumber', coalesce(users.phone_number, ''),
+               'pronouns', case
+                               when pronouns.id is not null then jsonb_build_object(
+                                       'subjective', pronouns.subjective,
+                                       'objective', pronouns.objective,
+                                       'possessive', pronouns.possessive,
+                                       'askMe', pronouns.ask_me
+                                   ) end,
+               'age', users.age,
+               'role', users.role,
+               'gender', users.gender,
+               'race', to_jsonb(users.race),
+               'mailingAddress', case
+                                     when mailing_addresses.user_id is not null then jsonb_build_object(
+                                             'country', mailing_addresses.country,
+                                             'state', mailing_addresses.state,
+                                             'city', mailing_addresses.city,
+                                             'postalCode', mailing_addresses.postal_code,
+                                             'addressLines', to_jsonb(mailing_addresses.address_lines)
+                                         ) end,
+               'mlh', case
+                          when mlh_terms.user_id is not null then jsonb_build_object(
+                                  'sendMessages', mlh_terms.send_messages,
+                                  'codeOfConduct', mlh_terms.code_of_conduct,
+                                  'shareInfo', mlh_terms.share_info
+                              ) end,
+               'shirtSize', users.shirt_size,
+               'yearsOfExperience', users.years_of_experience,
+               'educationInfo', case
+                                    when education_info.user_id is not null then jsonb_build_object(
+                                            'name', education_info.name,
+                                            'graduationDate', to_char(education_info.graduation_date, 'YYYY-MM-DD"T"HH24:MI:SS"Z"'),
+                                            'major', education_info.major,
+                                            'level', education_info.level
+                                        ) end
+           )),
+       timezone('utc', now())
+from users
+         left join pronouns on pronouns.id = users.pronoun_id
+         left join mailing_addresses on mailing_addresses.user_id = users.id
+         left join mlh_terms on mlh_terms.user_id = users.id
+         left join education_info on education_info.user_id = users.id
+where not exists(select 1 from user_versions where user_versions.user_id = users.id);
+
+commit;
@@ -0,0 +1,15 @@
+-- Adds users.created to a database created before registration times were recorded, see init.sql
+-- for the full schema. When existing users registered isn't known so their created is left null,
+-- stamping them with the time of the migration would make all of them match registeredAfter.
+begin;
+
+alter table users
+    add column if not exists created timestamp;
+
+alter table users
+    alter column created set default timezone('utc', now());
+
+create index if not exists users_created_index
+    on users (created);
+
+commit;
@@ -0,0 +1,9 @@
+-- Adds users.deleted_at to a database created before users could be soft deleted, see init.sql for
+-- the full schema. Existing users are left null, none of them are deleted. This must be run before
+-- migrations/oauth_identities_deleted_at.sql.
+begin;
+
+alter table users
+    add column if not exists deleted_at timestamp;
+
+commit;
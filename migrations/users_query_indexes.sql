@@ -0,0 +1,31 @@
+-- Adds the indexes used by the filters and sorts of the users query to a database created before
+-- users could be filtered and sorted, see init.sql for the full schema. The sort expressions must
+-- stay the same as userSorts in users_page.go. This must be run after
+-- migrations/users_created.sql.
+begin;
+
+create index if not exists users_newest_index
+    on users (coalesce(created, '-infinity'), id);
+
+create index if not exists users_role_index
+    on users (role);
+
+create index if not exists users_first_name_index
+    on users (lower(first_name), id);
+
+create index if not exists users_last_name_index
+    on users (lower(last_name), id);
+
+create index if not exists users_age_index
+    on users (coalesce(age, -1), id);
+
+create index if not exists mailing_addresses_country_state_index
+    on mailing_addresses (lower(country), lower(state));
+
+create index if not exists education_info_graduation_date_index
+    on education_info (graduation_date);
+
+create index if not exists education_info_level_index
+    on education_info (level);
+
+commit;
@@ -0,0 +1,246 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/KnightHacks/knighthacks_shared/auth"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"golang.org/x/oauth2"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+var (
+	ProviderNotConfigured = errors.New("provider is not configured")
+	NoProvidersConfigured = errors.New("no oauth providers are configured, set OAUTH_<PROVIDER>_CLIENT_ID for at least one provider")
+)
+
+// Profile is what a provider knows about the user an access token was issued to
+type Profile struct {
+	// UID identifies the user within the provider, it never changes
+	UID       string
+	FirstName string
+	LastName  string
+	Email     string
+	// VerifiedEmails are the emails the provider has verified belong to the user
+	VerifiedEmails []string
+	AvatarURL      string
+	// Username is the user's handle on the provider, e.g. their GitHub login
+	Username string
+}
+
+// Provider is an OAuth provider users can login with
+type Provider interface {
+	// AuthCodeURL is the page the user is sent to login, the state's redirect replaces the
+	// configured redirect url when it is given
+	AuthCodeURL(state *State) string
+	// Exchange exchanges the code the provider redirected the user back with for a token, state
+	// must be the state the user was sent to AuthCodeURL with
+	Exchange(ctx context.Context, code string, state *State) (*oauth2.Token, error)
+	UID(ctx context.Context, accessToken string) (string, error)
+	Profile(ctx context.Context, accessToken string) (*Profile, error)
+}
+
+// Endpoints are the urls of a provider, they are configurable so tests can point a provider
+// at a fake server
+type Endpoints struct {
+	AuthURL  string
+	TokenURL string
+	// APIURL is where the user's profile is fetched from, paths are appended to it
+	APIURL string
+}
+
+// Config is what a provider is created from
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	Endpoints    Endpoints
+}
+
+// ProfileFunc fetches the profile of the user from the provider's api, the client sends the
+// user's access token with every request
+type ProfileFunc func(ctx context.Context, client *APIClient) (*Profile, error)
+
+// APIClient sends authenticated requests to the provider's api
+type APIClient struct {
+	client *http.Client
+	apiURL string
+}
+
+// GetJSON decodes the JSON response of a GET request to the path into v
+func (c *APIClient) GetJSON(ctx context.Context, path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.apiURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s responded with status %d", path, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// oauth2Provider implements Provider for any provider that uses the authorization code flow
+type oauth2Provider struct {
+	config  *oauth2.Config
+	apiURL  string
+	profile ProfileFunc
+}
+
+// New creates a provider that uses the authorization code flow, profile turns the provider's
+// api responses into a Profile
+func New(config Config, profile ProfileFunc) Provider {
+	return &oauth2Provider{
+		config: &oauth2.Config{
+			ClientID:     config.ClientID,
+			ClientSecret: config.ClientSecret,
+			RedirectURL:  config.RedirectURL,
+			Scopes:       config.Scopes,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  config.Endpoints.AuthURL,
+				TokenURL: config.Endpoints.TokenURL,
+			},
+		},
+		apiURL:  strings.TrimSuffix(config.Endpoints.APIURL, "/"),
+		profile: profile,
+	}
+}
+
+func (p *oauth2Provider) AuthCodeURL(state *State) string {
+	opts := []oauth2.AuthCodeOption{
+		oauth2.SetAuthURLParam("code_challenge", CodeChallenge(state.CodeVerifier)),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	}
+	if state.RedirectURL != nil {
+		opts = append(opts, oauth2.SetAuthURLParam("redirect_uri", *state.RedirectURL))
+	}
+	return p.config.AuthCodeURL(state.State, opts...)
+}
+
+func (p *oauth2Provider) Exchange(ctx context.Context, code string, state *State) (*oauth2.Token, error) {
+	opts := []oauth2.AuthCodeOption{oauth2.SetAuthURLParam("code_verifier", state.CodeVerifier)}
+	// the redirect must be the same one the code was issued for
+	if state.RedirectURL != nil {
+		opts = append(opts, oauth2.SetAuthURLParam("redirect_uri", *state.RedirectURL))
+	}
+	return p.config.Exchange(withHTTPClient(ctx), code, opts...)
+}
+
+func (p *oauth2Provider) UID(ctx context.Context, accessToken string) (string, error) {
+	profile, err := p.Profile(ctx, accessToken)
+	if err != nil {
+		return "", err
+	}
+	return profile.UID, nil
+}
+
+func (p *oauth2Provider) Profile(ctx context.Context, accessToken string) (*Profile, error) {
+	client := oauth2.NewClient(withHTTPClient(ctx), oauth2.StaticTokenSource(&oauth2.Token{AccessToken: accessToken}))
+	profile, err := p.profile(ctx, &APIClient{client: client, apiURL: p.apiURL})
+	if err != nil {
+		return nil, err
+	}
+	if profile.UID == "" {
+		return nil, errors.New("provider did not return the user's id")
+	}
+	return profile, nil
+}
+
+// httpClient is used for every request to a provider so a slow provider can't hold up a request forever
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+func withHTTPClient(ctx context.Context) context.Context {
+	return context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+}
+
+// Registry holds the configured providers
+type Registry map[model.OAuthProvider]Provider
+
+func (r Registry) Get(provider model.OAuthProvider) (Provider, error) {
+	p, ok := r[provider]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ProviderNotConfigured, provider)
+	}
+	return p, nil
+}
+
+// constructors create each provider this service supports
+var constructors = map[model.OAuthProvider]func(config Config) Provider{
+	model.OAuthProviderGithub:    NewGitHub,
+	model.OAuthProviderGmail:     NewGoogle,
+	model.OAuthProviderDiscord:   NewDiscord,
+	model.OAuthProviderMicrosoft: NewMicrosoft,
+}
+
+// RegistryFromEnvironment creates every provider that has OAUTH_<PROVIDER>_CLIENT_ID set, along
+// with OAUTH_<PROVIDER>_CLIENT_SECRET and OAUTH_<PROVIDER>_REDIRECT_URL, e.g. OAUTH_DISCORD_CLIENT_ID.
+// GitHub and Gmail fall back to sharedAuth's config when they aren't set, sharedAuth may be nil.
+// At least one provider must be configured or nobody would be able to login.
+func RegistryFromEnvironment(sharedAuth *auth.Auth) (Registry, error) {
+	registry := Registry{}
+	for _, provider := range model.AllOAuthProvider {
+		prefix := "OAUTH_" + provider.String() + "_"
+		clientId := os.Getenv(prefix + "CLIENT_ID")
+		if clientId == "" {
+			if sharedProvider, ok := sharedAuthProviders[provider]; ok && sharedAuth != nil {
+				log.Printf("%sCLIENT_ID is not set, users will login with %s using the shared auth config\n", prefix, provider)
+				registry[provider] = NewSharedAuth(sharedAuth, sharedProvider)
+				continue
+			}
+			log.Printf("%sCLIENT_ID is not set, users will not be able to login with %s\n", prefix, provider)
+			continue
+		}
+		config := Config{
+			ClientID:     clientId,
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+		}
+		if config.ClientSecret == "" || config.RedirectURL == "" {
+			return nil, fmt.Errorf("%sCLIENT_SECRET and %sREDIRECT_URL must be set when %sCLIENT_ID is set", prefix, prefix, prefix)
+		}
+		registry[provider] = constructors[provider](config)
+	}
+	if len(registry) == 0 {
+		return nil, NoProvidersConfigured
+	}
+	return registry, nil
+}
+
+// withDefaults fills in the endpoints and scopes that weren't configured
+func withDefaults(config Config, endpoints Endpoints, scopes []string) Config {
+	if config.Endpoints.AuthURL == "" {
+		config.Endpoints.AuthURL = endpoints.AuthURL
+	}
+	if config.Endpoints.TokenURL == "" {
+		config.Endpoints.TokenURL = endpoints.TokenURL
+	}
+	if config.Endpoints.APIURL == "" {
+		config.Endpoints.APIURL = endpoints.APIURL
+	}
+	if config.Scopes == nil {
+		config.Scopes = scopes
+	}
+	return config
+}
+
+// splitName splits a full name into a first and last name at the last space
+func splitName(name string) (string, string) {
+	name = strings.TrimSpace(name)
+	i := strings.LastIndex(name, " ")
+	if i == -1 {
+		return name, ""
+	}
+	return strings.TrimSpace(name[:i]), name[i+1:]
+}
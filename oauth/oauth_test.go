@@ -0,0 +1,236 @@
+package oauth_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/KnightHacks/knighthacks_shared/auth"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/oauth"
+	"github.com/KnightHacks/knighthacks_users/oauth/oauthtest"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestProvider_Login(t *testing.T) {
+	server := oauthtest.NewServer()
+	defer server.Close()
+	provider := server.Provider()
+
+	want := &oauth.Profile{
+		UID:            "1234",
+		FirstName:      "Joe",
+		LastName:       "Bob",
+		Email:          "joe.bob@example.com",
+		VerifiedEmails: []string{"joe.bob@example.com"},
+	}
+	login, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the user is sent to the auth code url and redirected back with a code once they login
+	client := &http.Client{CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	state, err := oauth.NewState(model.OAuthProviderGithub, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(provider.AuthCodeURL(state) + "&login=" + url.QueryEscape(string(login)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	redirect, err := resp.Location()
+	if err != nil {
+		t.Fatalf("AuthCodeURL() did not redirect back, status = %d", resp.StatusCode)
+	}
+	if redirect.Query().Get("state") != state.State {
+		t.Errorf("redirect state = %v, want %v", redirect.Query().Get("state"), state.State)
+	}
+
+	// the code can't be exchanged without the state's code verifier
+	wrongVerifier := *state
+	wrongVerifier.CodeVerifier = "not-the-verifier"
+	if _, err = provider.Exchange(context.Background(), redirect.Query().Get("code"), &wrongVerifier); err == nil {
+		t.Errorf("Exchange() exchanged the code with the wrong code verifier")
+	}
+	resp, err = client.Get(provider.AuthCodeURL(state) + "&login=" + url.QueryEscape(string(login)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if redirect, err = resp.Location(); err != nil {
+		t.Fatalf("AuthCodeURL() did not redirect back, status = %d", resp.StatusCode)
+	}
+
+	token, err := provider.Exchange(context.Background(), redirect.Query().Get("code"), state)
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	got, err := provider.Profile(context.Background(), token.AccessToken)
+	if err != nil {
+		t.Fatalf("Profile() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Profile() got = %v, want %v", got, want)
+	}
+	uid, err := provider.UID(context.Background(), token.AccessToken)
+	if err != nil || uid != want.UID {
+		t.Errorf("UID() = %v, %v, want %v", uid, err, want.UID)
+	}
+
+	if _, err = provider.Exchange(context.Background(), redirect.Query().Get("code"), state); err == nil {
+		t.Errorf("Exchange() exchanged the same code twice")
+	}
+}
+
+func TestProvider_AuthCodeURLRedirect(t *testing.T) {
+	provider := oauth.NewDiscord(oauth.Config{ClientID: "client-id", RedirectURL: "http://localhost/callback"})
+	redirect := "http://localhost:3000/callback"
+	state, err := oauth.NewState(model.OAuthProviderDiscord, &redirect)
+	if err != nil {
+		t.Fatal(err)
+	}
+	link, err := url.Parse(provider.AuthCodeURL(state))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if link.Host != "discord.com" {
+		t.Errorf("AuthCodeURL() host = %v, want discord.com", link.Host)
+	}
+	if got := link.Query().Get("redirect_uri"); got != redirect {
+		t.Errorf("AuthCodeURL() redirect_uri = %v, want %v", got, redirect)
+	}
+	if got := link.Query().Get("code_challenge"); got != oauth.CodeChallenge(state.CodeVerifier) {
+		t.Errorf("AuthCodeURL() code_challenge = %v, want %v", got, oauth.CodeChallenge(state.CodeVerifier))
+	}
+}
+
+func TestProviders_Profile(t *testing.T) {
+	tests := []struct {
+		name      string
+		provider  func(config oauth.Config) oauth.Provider
+		responses map[string]string
+		want      *oauth.Profile
+	}{
+		{
+			name:     "github",
+			provider: oauth.NewGitHub,
+			responses: map[string]string{
+				"/user":        `{"id": 1234, "login": "joebob", "name": "Joe Bob", "email": null, "avatar_url": "https://avatars.githubusercontent.com/u/1234?v=4"}`,
+				"/user/emails": `[{"email": "joe@example.com", "primary": false, "verified": false}, {"email": "joe.bob@example.com", "primary": true, "verified": true}]`,
+			},
+			want: &oauth.Profile{UID: "1234", FirstName: "Joe", LastName: "Bob", Email: "joe.bob@example.com", VerifiedEmails: []string{"joe.bob@example.com"}, AvatarURL: "https://avatars.githubusercontent.com/u/1234?v=4", Username: "joebob"},
+		},
+		{
+			name:     "github without access to emails",
+			provider: oauth.NewGitHub,
+			responses: map[string]string{
+				"/user": `{"id": 1234, "login": "joebob", "name": "Joe Bob", "email": "joe@example.com", "avatar_url": ""}`,
+			},
+			want: &oauth.Profile{UID: "1234", FirstName: "Joe", LastName: "Bob", Email: "joe@example.com", VerifiedEmails: []string{}, Username: "joebob"},
+		},
+		{
+			name:     "google",
+			provider: oauth.NewGoogle,
+			responses: map[string]string{
+				"/v1/userinfo": `{"sub": "1234", "given_name": "Joe", "family_name": "Bob", "email": "joe.bob@gmail.com", "email_verified": true, "picture": "https://lh3.googleusercontent.com/a/joebob"}`,
+			},
+			want: &oauth.Profile{UID: "1234", FirstName: "Joe", LastName: "Bob", Email: "joe.bob@gmail.com", VerifiedEmails: []string{"joe.bob@gmail.com"}, AvatarURL: "https://lh3.googleusercontent.com/a/joebob"},
+		},
+		{
+			name:     "discord",
+			provider: oauth.NewDiscord,
+			responses: map[string]string{
+				"/users/@me": `{"id": "80351110224678912", "username": "joebob", "global_name": "Joe Bob", "email": "joe.bob@example.com", "verified": true, "avatar": "8342729096ea3675442027381ff50dfe"}`,
+			},
+			want: &oauth.Profile{UID: "80351110224678912", FirstName: "Joe", LastName: "Bob", Email: "joe.bob@example.com", VerifiedEmails: []string{"joe.bob@example.com"}, AvatarURL: "https://cdn.discordapp.com/avatars/80351110224678912/8342729096ea3675442027381ff50dfe.png", Username: "joebob"},
+		},
+		{
+			name:     "discord without a display name",
+			provider: oauth.NewDiscord,
+			responses: map[string]string{
+				"/users/@me": `{"id": "80351110224678912", "username": "joebob", "global_name": null, "email": "joe.bob@example.com", "verified": false, "avatar": null}`,
+			},
+			want: &oauth.Profile{UID: "80351110224678912", FirstName: "joebob", Email: "joe.bob@example.com", VerifiedEmails: []string{}, Username: "joebob"},
+		},
+		{
+			name:     "microsoft",
+			provider: oauth.NewMicrosoft,
+			responses: map[string]string{
+				"/v1.0/me": `{"id": "48d31887-5fad-4d73-a9f5-3c356e68a038", "givenName": "Joe", "surname": "Bob", "mail": null, "userPrincipalName": "jo123456@ucf.edu"}`,
+			},
+			want: &oauth.Profile{UID: "48d31887-5fad-4d73-a9f5-3c356e68a038", FirstName: "Joe", LastName: "Bob", Email: "jo123456@ucf.edu", VerifiedEmails: []string{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer access-token" {
+					http.Error(w, "invalid access token", http.StatusUnauthorized)
+					return
+				}
+				response, ok := tt.responses[r.URL.Path]
+				if !ok {
+					http.NotFound(w, r)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(response))
+			}))
+			defer server.Close()
+
+			provider := tt.provider(oauth.Config{Endpoints: oauth.Endpoints{APIURL: server.URL}})
+			got, err := provider.Profile(context.Background(), "access-token")
+			if err != nil {
+				t.Fatalf("Profile() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Profile() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistry_Get(t *testing.T) {
+	registry := oauth.Registry{model.OAuthProviderDiscord: oauth.NewDiscord(oauth.Config{})}
+	if _, err := registry.Get(model.OAuthProviderDiscord); err != nil {
+		t.Errorf("Get() error = %v", err)
+	}
+	if _, err := registry.Get(model.OAuthProviderMicrosoft); !errors.Is(err, oauth.ProviderNotConfigured) {
+		t.Errorf("Get() error = %v, want %v", err, oauth.ProviderNotConfigured)
+	}
+}
+
+func TestRegistryFromEnvironment(t *testing.T) {
+	for _, provider := range model.AllOAuthProvider {
+		t.Setenv("OAUTH_"+provider.String()+"_CLIENT_ID", "")
+	}
+	if _, err := oauth.RegistryFromEnvironment(nil); !errors.Is(err, oauth.NoProvidersConfigured) {
+		t.Errorf("RegistryFromEnvironment() error = %v, want %v", err, oauth.NoProvidersConfigured)
+	}
+
+	// github and gmail fall back to the shared auth config
+	registry, err := oauth.RegistryFromEnvironment(&auth.Auth{})
+	if err != nil {
+		t.Fatalf("RegistryFromEnvironment() error = %v", err)
+	}
+	if len(registry) != 2 || registry[model.OAuthProviderGithub] == nil || registry[model.OAuthProviderGmail] == nil {
+		t.Errorf("RegistryFromEnvironment() = %v, want github and gmail", registry)
+	}
+
+	t.Setenv("OAUTH_DISCORD_CLIENT_ID", "client-id")
+	if _, err = oauth.RegistryFromEnvironment(nil); err == nil {
+		t.Errorf("RegistryFromEnvironment() created discord without a client secret")
+	}
+	t.Setenv("OAUTH_DISCORD_CLIENT_SECRET", "client-secret")
+	t.Setenv("OAUTH_DISCORD_REDIRECT_URL", "http://localhost:3000/callback")
+	if registry, err = oauth.RegistryFromEnvironment(nil); err != nil || len(registry) != 1 || registry[model.OAuthProviderDiscord] == nil {
+		t.Errorf("RegistryFromEnvironment() = %v, %v, want only discord", registry, err)
+	}
+}
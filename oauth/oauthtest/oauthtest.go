@@ -0,0 +1,151 @@
+// Package oauthtest provides a fake OAuth provider for tests
+package oauthtest
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/KnightHacks/knighthacks_users/oauth"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Server is a fake OAuth provider running on an httptest server. Codes are issued with Code and
+// are exchanged for an access token that returns the profile the code was issued for.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	codes    map[string]*code
+	profiles map[string]*oauth.Profile
+}
+
+type code struct {
+	profile *oauth.Profile
+	// codeChallenge is the PKCE challenge the code was issued with, the code can only be
+	// exchanged with its verifier
+	codeChallenge string
+}
+
+// NewServer starts a fake provider, it must be closed when the test is done
+func NewServer() *Server {
+	s := &Server{codes: map[string]*code{}, profiles: map[string]*oauth.Profile{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authorize", s.authorize)
+	mux.HandleFunc("/token", s.token)
+	mux.HandleFunc("/user", s.user)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// Code issues a code that logs in as the profile, it can only be exchanged once and
+// without PKCE
+func (s *Server) Code(profile *oauth.Profile) string {
+	return s.issueCode(profile, "")
+}
+
+func (s *Server) issueCode(profile *oauth.Profile, codeChallenge string) string {
+	c := randomString()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.codes[c] = &code{profile: profile, codeChallenge: codeChallenge}
+	return c
+}
+
+// Provider returns a provider that logs in with the server
+func (s *Server) Provider() oauth.Provider {
+	return oauth.New(oauth.Config{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  s.URL + "/callback",
+		Endpoints: oauth.Endpoints{
+			AuthURL:  s.URL + "/authorize",
+			TokenURL: s.URL + "/token",
+			APIURL:   s.URL,
+		},
+	}, func(ctx context.Context, client *oauth.APIClient) (*oauth.Profile, error) {
+		var profile oauth.Profile
+		if err := client.GetJSON(ctx, "/user", &profile); err != nil {
+			return nil, err
+		}
+		return &profile, nil
+	})
+}
+
+// authorize logs in as the profile given in the login query parameter as JSON and redirects back
+// with a code, the same way a real provider would once the user has logged in
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) {
+	var profile oauth.Profile
+	if err := json.Unmarshal([]byte(r.URL.Query().Get("login")), &profile); err != nil {
+		http.Error(w, "login must be a profile", http.StatusBadRequest)
+		return
+	}
+	redirect, err := url.Parse(r.URL.Query().Get("redirect_uri"))
+	if err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	query := redirect.Query()
+	if r.URL.Query().Get("code_challenge") != "" && r.URL.Query().Get("code_challenge_method") != "S256" {
+		http.Error(w, "only S256 code challenges are supported", http.StatusBadRequest)
+		return
+	}
+	query.Set("code", s.issueCode(&profile, r.URL.Query().Get("code_challenge")))
+	query.Set("state", r.URL.Query().Get("state"))
+	redirect.RawQuery = query.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	c, ok := s.codes[r.PostForm.Get("code")]
+	delete(s.codes, r.PostForm.Get("code"))
+	if ok && c.codeChallenge != "" && oauth.CodeChallenge(r.PostForm.Get("code_verifier")) != c.codeChallenge {
+		ok = false
+	}
+	accessToken := randomString()
+	if ok {
+		s.profiles[accessToken] = c.profile
+	}
+	s.mu.Unlock()
+	if !ok {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+func (s *Server) user(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	profile, ok := s.profiles[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+	s.mu.Unlock()
+	if !ok {
+		http.Error(w, "invalid access token", http.StatusUnauthorized)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(profile)
+}
+
+func randomString() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,161 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+)
+
+var (
+	GitHubEndpoints = Endpoints{
+		AuthURL:  "https://github.com/login/oauth/authorize",
+		TokenURL: "https://github.com/login/oauth/access_token",
+		APIURL:   "https://api.github.com",
+	}
+	GoogleEndpoints = Endpoints{
+		AuthURL:  "https://accounts.google.com/o/oauth2/auth",
+		TokenURL: "https://oauth2.googleapis.com/token",
+		APIURL:   "https://openidconnect.googleapis.com",
+	}
+	DiscordEndpoints = Endpoints{
+		AuthURL:  "https://discord.com/oauth2/authorize",
+		TokenURL: "https://discord.com/api/oauth2/token",
+		APIURL:   "https://discord.com/api",
+	}
+	// DiscordCDNURL is where Discord serves avatars from
+	DiscordCDNURL = "https://cdn.discordapp.com"
+	// MicrosoftEndpoints allow both personal and work or school accounts, which is what UCF
+	// students have
+	MicrosoftEndpoints = Endpoints{
+		AuthURL:  "https://login.microsoftonline.com/common/oauth2/v2.0/authorize",
+		TokenURL: "https://login.microsoftonline.com/common/oauth2/v2.0/token",
+		APIURL:   "https://graph.microsoft.com",
+	}
+)
+
+func NewGitHub(config Config) Provider {
+	return New(withDefaults(config, GitHubEndpoints, []string{"read:user", "user:email"}), func(ctx context.Context, client *APIClient) (*Profile, error) {
+		var user struct {
+			ID        int64  `json:"id"`
+			Login     string `json:"login"`
+			Name      string `json:"name"`
+			Email     string `json:"email"`
+			AvatarURL string `json:"avatar_url"`
+		}
+		if err := client.GetJSON(ctx, "/user", &user); err != nil {
+			return nil, err
+		}
+		// the emails only fill in the profile, the user can still login without them
+		var emails []struct {
+			Email    string `json:"email"`
+			Primary  bool   `json:"primary"`
+			Verified bool   `json:"verified"`
+		}
+		if err := client.GetJSON(ctx, "/user/emails", &emails); err != nil {
+			log.Printf("unable to get the user's github emails, err = %v\n", err)
+			emails = nil
+		}
+
+		profile := &Profile{Email: user.Email, VerifiedEmails: []string{}, AvatarURL: user.AvatarURL, Username: user.Login}
+		if user.ID != 0 {
+			profile.UID = strconv.FormatInt(user.ID, 10)
+		}
+		profile.FirstName, profile.LastName = splitName(user.Name)
+		for _, email := range emails {
+			if email.Primary {
+				profile.Email = email.Email
+			}
+			if email.Verified {
+				profile.VerifiedEmails = append(profile.VerifiedEmails, email.Email)
+			}
+		}
+		return profile, nil
+	})
+}
+
+func NewGoogle(config Config) Provider {
+	return New(withDefaults(config, GoogleEndpoints, []string{"openid", "profile", "email"}), func(ctx context.Context, client *APIClient) (*Profile, error) {
+		var userInfo struct {
+			Sub           string `json:"sub"`
+			GivenName     string `json:"given_name"`
+			FamilyName    string `json:"family_name"`
+			Email         string `json:"email"`
+			EmailVerified bool   `json:"email_verified"`
+			Picture       string `json:"picture"`
+		}
+		if err := client.GetJSON(ctx, "/v1/userinfo", &userInfo); err != nil {
+			return nil, err
+		}
+		profile := &Profile{
+			UID:            userInfo.Sub,
+			FirstName:      userInfo.GivenName,
+			LastName:       userInfo.FamilyName,
+			Email:          userInfo.Email,
+			VerifiedEmails: []string{},
+			AvatarURL:      userInfo.Picture,
+		}
+		if userInfo.EmailVerified && userInfo.Email != "" {
+			profile.VerifiedEmails = append(profile.VerifiedEmails, userInfo.Email)
+		}
+		return profile, nil
+	})
+}
+
+func NewDiscord(config Config) Provider {
+	return New(withDefaults(config, DiscordEndpoints, []string{"identify", "email"}), func(ctx context.Context, client *APIClient) (*Profile, error) {
+		var user struct {
+			ID       string `json:"id"`
+			Username string `json:"username"`
+			// GlobalName is the display name, Discord has no first and last name
+			GlobalName string `json:"global_name"`
+			Email      string `json:"email"`
+			Verified   bool   `json:"verified"`
+			// Avatar is the hash of the avatar, it is null when the user hasn't set one
+			Avatar string `json:"avatar"`
+		}
+		if err := client.GetJSON(ctx, "/users/@me", &user); err != nil {
+			return nil, err
+		}
+		profile := &Profile{UID: user.ID, Email: user.Email, VerifiedEmails: []string{}, Username: user.Username}
+		if user.Avatar != "" {
+			profile.AvatarURL = fmt.Sprintf("%s/avatars/%s/%s.png", DiscordCDNURL, user.ID, user.Avatar)
+		}
+		if user.GlobalName == "" {
+			user.GlobalName = user.Username
+		}
+		profile.FirstName, profile.LastName = splitName(user.GlobalName)
+		if user.Verified && user.Email != "" {
+			profile.VerifiedEmails = append(profile.VerifiedEmails, user.Email)
+		}
+		return profile, nil
+	})
+}
+
+func NewMicrosoft(config Config) Provider {
+	return New(withDefaults(config, MicrosoftEndpoints, []string{"openid", "profile", "email", "User.Read"}), func(ctx context.Context, client *APIClient) (*Profile, error) {
+		var user struct {
+			ID                string `json:"id"`
+			GivenName         string `json:"givenName"`
+			Surname           string `json:"surname"`
+			Mail              string `json:"mail"`
+			UserPrincipalName string `json:"userPrincipalName"`
+		}
+		if err := client.GetJSON(ctx, "/v1.0/me", &user); err != nil {
+			return nil, err
+		}
+		profile := &Profile{
+			UID:       user.ID,
+			FirstName: user.GivenName,
+			LastName:  user.Surname,
+			Email:     user.Mail,
+			// Microsoft doesn't say whether the mail has been verified, so it has to be verified by link
+			VerifiedEmails: []string{},
+			// the photo can only be downloaded with the access token so there is no avatar url
+		}
+		if profile.Email == "" {
+			profile.Email = user.UserPrincipalName
+		}
+		return profile, nil
+	})
+}
@@ -0,0 +1,48 @@
+package oauth
+
+import (
+	"context"
+	"github.com/KnightHacks/knighthacks_shared/auth"
+	"github.com/KnightHacks/knighthacks_shared/models"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"golang.org/x/oauth2"
+)
+
+// sharedAuthProviders are the providers the shared auth library is configured with, deployments
+// that only have the shared auth settings keep logging in with these
+var sharedAuthProviders = map[model.OAuthProvider]models.Provider{
+	model.OAuthProviderGithub: models.ProviderGithub,
+	model.OAuthProviderGmail:  models.ProviderGmail,
+}
+
+// sharedAuthProvider logs users in with the shared auth library's config for the provider. The
+// library doesn't support PKCE and only knows the user's id, so no profile is suggested.
+type sharedAuthProvider struct {
+	auth     *auth.Auth
+	provider models.Provider
+}
+
+// NewSharedAuth creates a provider from the shared auth library's config for the provider
+func NewSharedAuth(a *auth.Auth, provider models.Provider) Provider {
+	return &sharedAuthProvider{auth: a, provider: provider}
+}
+
+func (p *sharedAuthProvider) AuthCodeURL(state *State) string {
+	return p.auth.GetAuthCodeURL(p.provider, state.State, state.RedirectURL)
+}
+
+func (p *sharedAuthProvider) Exchange(ctx context.Context, code string, _ *State) (*oauth2.Token, error) {
+	return p.auth.ExchangeCode(ctx, p.provider, code)
+}
+
+func (p *sharedAuthProvider) UID(ctx context.Context, accessToken string) (string, error) {
+	return p.auth.GetUID(ctx, p.provider, accessToken)
+}
+
+func (p *sharedAuthProvider) Profile(ctx context.Context, accessToken string) (*Profile, error) {
+	uid, err := p.UID(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	return &Profile{UID: uid, VerifiedEmails: []string{}}, nil
+}
@@ -0,0 +1,134 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// StateExpiry is how long a user has to login with their provider after being sent to it
+const StateExpiry = 10 * time.Minute
+
+var (
+	StateNotValid      = errors.New("oauth state is not valid, it may have expired or already been used")
+	RedirectNotAllowed = errors.New("redirect is not allowed")
+)
+
+// State is what is remembered between sending the user to their provider and the user being
+// redirected back with a code
+type State struct {
+	State    string
+	Provider model.OAuthProvider
+	// CodeVerifier is the PKCE secret the code is exchanged with
+	CodeVerifier string
+	// RedirectURL is the redirect the user was sent to the provider with, nil if the provider's
+	// configured redirect url was used
+	RedirectURL *string
+	ExpiresAt   time.Time
+}
+
+// StateStore stores states until the user is redirected back, a state can only be taken once
+type StateStore interface {
+	Save(ctx context.Context, state *State) error
+	// Take removes the state from the store and returns it, StateNotValid is returned if the
+	// state doesn't exist or has expired
+	Take(ctx context.Context, state string) (*State, error)
+}
+
+// NewState creates a state for the provider with a random state and code verifier
+func NewState(provider model.OAuthProvider, redirect *string) (*State, error) {
+	state, err := randomURLString(16)
+	if err != nil {
+		return nil, err
+	}
+	codeVerifier, err := randomURLString(32)
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		State:        state,
+		Provider:     provider,
+		CodeVerifier: codeVerifier,
+		RedirectURL:  redirect,
+		ExpiresAt:    time.Now().Add(StateExpiry),
+	}, nil
+}
+
+// CodeChallenge is the S256 PKCE challenge of the code verifier, it is sent to the provider
+// along with the user so that only whoever knows the verifier can exchange the code
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// MemoryStateStore keeps states in memory, it only works when a single instance of the
+// service is running
+type MemoryStateStore struct {
+	mu     sync.Mutex
+	states map[string]*State
+}
+
+func NewMemoryStateStore() *MemoryStateStore {
+	return &MemoryStateStore{states: map[string]*State{}}
+}
+
+func (s *MemoryStateStore) Save(ctx context.Context, state *State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// expired states are removed here so the map doesn't grow forever
+	now := time.Now()
+	for key, stored := range s.states {
+		if !now.Before(stored.ExpiresAt) {
+			delete(s.states, key)
+		}
+	}
+	stored := *state
+	s.states[state.State] = &stored
+	return nil
+}
+
+func (s *MemoryStateStore) Take(ctx context.Context, state string) (*State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.states[state]
+	if !ok {
+		return nil, StateNotValid
+	}
+	delete(s.states, state)
+	if !time.Now().Before(stored.ExpiresAt) {
+		return nil, StateNotValid
+	}
+	return stored, nil
+}
+
+// ValidateRedirect checks the redirect is an absolute url on one of the allowed origins, e.g.
+// https://knighthacks.org
+func ValidateRedirect(redirect string, allowedOrigins []string) error {
+	u, err := url.Parse(redirect)
+	if err != nil || !u.IsAbs() || u.Host == "" || u.User != nil {
+		return fmt.Errorf("%w: %s is not an absolute url", RedirectNotAllowed, redirect)
+	}
+	origin := u.Scheme + "://" + u.Host
+	for _, allowed := range allowedOrigins {
+		if strings.EqualFold(origin, strings.TrimSuffix(strings.TrimSpace(allowed), "/")) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", RedirectNotAllowed, origin)
+}
+
+func randomURLString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
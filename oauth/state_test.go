@@ -0,0 +1,107 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCodeChallenge(t *testing.T) {
+	// the challenge is the sha256 of the verifier encoded with the url alphabet and no padding
+	got := CodeChallenge("dBjftJeZ4CVP-mJ0PS3HX-OysFZbFRFmZK3FgUAGiqg")
+	if want := "Ql4_CjJmmiOznIV95qjoQ9hEKlXMf0ASpRK5A0wwZIU"; got != want {
+		t.Errorf("CodeChallenge() = %v, want %v", got, want)
+	}
+}
+
+func TestMemoryStateStore(t *testing.T) {
+	store := NewMemoryStateStore()
+	state, err := NewState(model.OAuthProviderGithub, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Save(context.Background(), state); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	got, err := store.Take(context.Background(), state.State)
+	if err != nil {
+		t.Fatalf("Take() error = %v", err)
+	}
+	if got.CodeVerifier != state.CodeVerifier || got.Provider != state.Provider {
+		t.Errorf("Take() got = %v, want %v", got, state)
+	}
+	if _, err = store.Take(context.Background(), state.State); !errors.Is(err, StateNotValid) {
+		t.Errorf("Take() error = %v, want %v as a state can only be used once", err, StateNotValid)
+	}
+
+	expired, err := NewState(model.OAuthProviderGithub, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired.ExpiresAt = time.Now().Add(-time.Second)
+	if err = store.Save(context.Background(), expired); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if _, err = store.Take(context.Background(), expired.State); !errors.Is(err, StateNotValid) {
+		t.Errorf("Take() error = %v, want %v", err, StateNotValid)
+	}
+}
+
+func TestMemoryStateStore_Concurrent(t *testing.T) {
+	store := NewMemoryStateStore()
+	state, err := NewState(model.OAuthProviderGithub, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Save(context.Background(), state); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	taken := 0
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := store.Take(context.Background(), state.State); err == nil {
+				mu.Lock()
+				taken++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if taken != 1 {
+		t.Errorf("Take() succeeded %d times, want once", taken)
+	}
+}
+
+func TestValidateRedirect(t *testing.T) {
+	allowed := []string{"https://knighthacks.org", "http://localhost:3000/"}
+	tests := []struct {
+		redirect string
+		wantErr  bool
+	}{
+		{redirect: "https://knighthacks.org/auth/callback"},
+		{redirect: "http://localhost:3000/callback"},
+		{redirect: "https://knighthacks.org.evil.com/callback", wantErr: true},
+		{redirect: "http://knighthacks.org/callback", wantErr: true},
+		{redirect: "https://knighthacks.org@evil.com/callback", wantErr: true},
+		{redirect: "/callback", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.redirect, func(t *testing.T) {
+			err := ValidateRedirect(tt.redirect, allowed)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRedirect() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, RedirectNotAllowed) {
+				t.Errorf("ValidateRedirect() error = %v, want %v", err, RedirectNotAllowed)
+			}
+		})
+	}
+}
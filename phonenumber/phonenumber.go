@@ -0,0 +1,87 @@
+package phonenumber
+
+import (
+	"errors"
+	"github.com/nyaruka/phonenumbers"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+	"strings"
+)
+
+// DefaultRegion is used when the user hasn't given a country, most of our attendees are in the US
+const DefaultRegion = "US"
+
+// UnknownRegion is used for countries that aren't recognized, only numbers that start with + or
+// an international prefix can be parsed without a region
+const UnknownRegion = "ZZ"
+
+var InvalidPhoneNumber = errors.New("invalid phone number")
+
+// countryAliases are names users write in their mailing address that aren't the English name of
+// the region
+var countryAliases = map[string]string{
+	"united states of america": "US",
+	"usa":                      "US",
+	"america":                  "US",
+	"uk":                       "GB",
+	"great britain":            "GB",
+	"england":                  "GB",
+	"scotland":                 "GB",
+	"wales":                    "GB",
+	"korea":                    "KR",
+	"republic of korea":        "KR",
+	"holland":                  "NL",
+}
+
+// countryNames maps the lowercase English name of every region phone numbers are known for to the
+// region
+var countryNames = func() map[string]string {
+	names := make(map[string]string, len(countryAliases))
+	for name, code := range countryAliases {
+		names[name] = code
+	}
+	regionNames := display.English.Regions()
+	for code := range phonenumbers.GetSupportedRegions() {
+		region, err := language.ParseRegion(code)
+		if err != nil {
+			continue
+		}
+		if name := regionNames.Name(region); name != "" {
+			names[strings.ToLower(name)] = code
+		}
+	}
+	return names
+}()
+
+// RegionForCountry returns the region of a mailing address country, either the country's
+// name or its ISO 3166-1 alpha-2 code. DefaultRegion is returned when there is no country and
+// UnknownRegion for countries that aren't recognized.
+func RegionForCountry(country string) string {
+	country = strings.ToLower(strings.TrimSpace(country))
+	if country == "" {
+		return DefaultRegion
+	}
+	if code, ok := countryNames[country]; ok {
+		return code
+	}
+	code := strings.ToUpper(country)
+	if _, ok := phonenumbers.GetSupportedRegions()[code]; ok {
+		return code
+	}
+	return UnknownRegion
+}
+
+// Normalize parses the phone number as the user typed it and returns it in E.164, such as
+// +14075550100. Numbers that don't start with + or the region's international prefix are parsed
+// as national numbers of the region, so they are invalid if the region is UnknownRegion.
+func Normalize(number string, regionCode string) (string, error) {
+	parsed, err := phonenumbers.Parse(number, regionCode)
+	if err != nil {
+		return "", InvalidPhoneNumber
+	}
+	// E.164 has no room for an extension, it would be silently dropped
+	if parsed.GetExtension() != "" || !phonenumbers.IsValidNumber(parsed) {
+		return "", InvalidPhoneNumber
+	}
+	return phonenumbers.Format(parsed, phonenumbers.E164), nil
+}
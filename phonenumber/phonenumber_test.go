@@ -0,0 +1,61 @@
+package phonenumber
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		number  string
+		region  string
+		want    string
+		wantErr bool
+	}{
+		{number: "407-555-0100", region: "US", want: "+14075550100"},
+		{number: "(407) 5550100", region: "US", want: "+14075550100"},
+		{number: "+14075550100", region: "US", want: "+14075550100"},
+		{number: "1 407.555.0100", region: "US", want: "+14075550100"},
+		{number: "001 407 555 0100", region: "GB", want: "+14075550100"},
+		{number: "011 44 20 7946 0958", region: "US", want: "+442079460958"},
+		{number: "+44 20 7946 0958", region: "US", want: "+442079460958"},
+		{number: "020 7946 0958", region: "GB", want: "+442079460958"},
+		{number: "030 12345678", region: "DE", want: "+493012345678"},
+		{number: "55 1234 5678", region: "MX", want: "+525512345678"},
+		{number: "+14075550100", region: UnknownRegion, want: "+14075550100"},
+		{number: "407-555-0100", region: UnknownRegion, wantErr: true},
+		{number: "407-123-4567", region: "US", wantErr: true},
+		{number: "100-200-3000", region: "US", wantErr: true},
+		{number: "407-555-010", region: "US", wantErr: true},
+		{number: "407-555-0100 ext 2", region: "US", wantErr: true},
+		{number: "+1234567890123456", region: "US", wantErr: true},
+		{number: "", region: "US", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.number, func(t *testing.T) {
+			got, err := Normalize(tt.number, tt.region)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Normalize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegionForCountry(t *testing.T) {
+	tests := map[string]string{
+		"United States": "US",
+		" usa ":         "US",
+		"Canada":        "CA",
+		"gb":            "GB",
+		"Germany":       "DE",
+		"Mexico":        "MX",
+		"South Korea":   "KR",
+		"Atlantis":      UnknownRegion,
+		"":              DefaultRegion,
+	}
+	for country, want := range tests {
+		if got := RegionForCountry(country); got != want {
+			t.Errorf("RegionForCountry(%q) = %v, want %v", country, got, want)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/KnightHacks/knighthacks_shared/models"
+)
+
+// ActorContextKey is the key the Actor of a request is stored under
+const ActorContextKey = "AuditActor"
+
+// Actor is who made a change and the request it was made in, it is recorded in the audit log.
+// UserID and Role are empty when the change wasn't made by a logged-in user, e.g. by a purge.
+type Actor struct {
+	UserID    string
+	Role      models.Role
+	RequestID string
+}
+
+// WithActor returns a copy of the context that changes are recorded as made by the actor
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, ActorContextKey, actor)
+}
+
+// ActorFromContext returns the actor stored by WithActor, the zero Actor if there is none
+func ActorFromContext(ctx context.Context) Actor {
+	actor, _ := ctx.Value(ActorContextKey).(Actor)
+	return actor
+}
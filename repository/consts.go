@@ -5,4 +5,29 @@ import "errors"
 var (
 	UserNotFound      = errors.New("user not found")
 	UserAlreadyExists = errors.New("user with id already exists")
+	UserNotDeleted    = errors.New("user is not deleted")
+	APIKeyNotFound    = errors.New("api key not found")
+	LastAdmin         = errors.New("cannot demote or remove the last admin")
+
+	RestoreWindowExpired = errors.New("user was deleted too long ago to be restored")
+
+	CannotMergeSameUser = errors.New("cannot merge a user into themselves")
+
+	PronounNotFound         = errors.New("pronoun option not found")
+	CannotMergeSamePronouns = errors.New("cannot merge pronouns into themselves")
+
+	EmailAlreadyInUse   = errors.New("email is already in use by another user")
+	EmailUnchanged      = errors.New("user already has this email")
+	EmailChangeNotValid = errors.New("email change link is not valid, it may have expired or already been used")
+
+	EmailAlreadyVerified      = errors.New("email is already verified")
+	EmailVerificationNotValid = errors.New("email verification link is not valid, it may have expired or the email has changed since it was sent")
+
+	OAuthIdentityNotFound      = errors.New("oauth identity not found")
+	OAuthIdentityAlreadyLinked = errors.New("oauth identity is already linked to another user")
+	ProviderAlreadyLinked      = errors.New("an account from this provider is already linked, unlink it first")
+	LastOAuthIdentity          = errors.New("cannot unlink the last oauth identity, the user would no longer be able to login")
+
+	RefreshTokenNotValid = errors.New("refresh token not valid, you must login again")
+	RefreshTokenReused   = errors.New("refresh token has already been used, the session has been logged out")
 )
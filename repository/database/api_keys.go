@@ -0,0 +1,164 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/jackc/pgx/v5"
+	"strconv"
+	"time"
+)
+
+// apiKeyLength is the amount of random bytes used for a new API key
+const apiKeyLength = 48
+
+// GenerateAPIKey generates a new cryptographically random API key
+func GenerateAPIKey() (string, error) {
+	return GenerateToken(apiKeyLength)
+}
+
+// HashAPIKey returns the hash of the API key, this is the only form of the key
+// that is ever stored in the database
+func HashAPIKey(key string) string {
+	return HashToken(key)
+}
+
+// GetAPIKeys returns every API key the user owns, the secret itself is never returned
+func (r *DatabaseRepository) GetAPIKeys(ctx context.Context, userId string) ([]*model.APIKey, error) {
+	rows, err := r.DatabasePool.Query(
+		ctx,
+		"SELECT id, name, scopes, created, expires_at, last_used_at FROM api_keys WHERE user_id = $1 ORDER BY created",
+		userId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	apiKeys := make([]*model.APIKey, 0)
+	for rows.Next() {
+		apiKey, err := ScanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		apiKeys = append(apiKeys, apiKey)
+	}
+	return apiKeys, rows.Err()
+}
+
+// AddAPIKey generates a new API key for the user and stores its hash, the returned
+// payload is the only place the secret key is ever available
+func (r *DatabaseRepository) AddAPIKey(ctx context.Context, userId string, input *model.NewAPIKey) (*model.NewAPIKeyPayload, error) {
+	key, err := GenerateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]string, 0, len(input.Scopes))
+	for _, scope := range input.Scopes {
+		scopes = append(scopes, scope.String())
+	}
+
+	apiKey := &model.APIKey{
+		Name:      input.Name,
+		Scopes:    input.Scopes,
+		Created:   time.Now().UTC(),
+		ExpiresAt: input.ExpiresAt,
+	}
+	var apiKeyId int
+	err = r.DatabasePool.QueryRow(
+		ctx,
+		"INSERT INTO api_keys (user_id, name, key_hash, scopes, created, expires_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id",
+		userId,
+		input.Name,
+		HashAPIKey(key),
+		scopes,
+		apiKey.Created,
+		input.ExpiresAt,
+	).Scan(&apiKeyId)
+	if err != nil {
+		return nil, err
+	}
+	apiKey.ID = strconv.Itoa(apiKeyId)
+
+	return &model.NewAPIKeyPayload{APIKey: apiKey, Key: key}, nil
+}
+
+// DeleteAPIKey revokes the API key with the id, the key must be owned by the user
+func (r *DatabaseRepository) DeleteAPIKey(ctx context.Context, userId string, id string) error {
+	// ids are serial, anything else can't be a key and would fail the cast in postgres
+	apiKeyId, err := strconv.Atoi(id)
+	if err != nil {
+		return repository.APIKeyNotFound
+	}
+	commandTag, err := r.DatabasePool.Exec(ctx, "DELETE FROM api_keys WHERE id = $1 AND user_id = $2", apiKeyId, userId)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return repository.APIKeyNotFound
+	}
+	return nil
+}
+
+// GetUserByAPIKey returns the user that owns the API key along with the key itself,
+// expired keys and the keys of soft deleted users are treated as if they do not exist.
+// Every successful lookup updates the last time the key was used.
+func (r *DatabaseRepository) GetUserByAPIKey(ctx context.Context, key string) (*model.User, *model.APIKey, error) {
+	var user *model.User
+	var apiKey *model.APIKey
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		now := time.Now().UTC()
+		var userId int
+		var err error
+		apiKey, err = ScanAPIKey(
+			tx.QueryRow(ctx, `UPDATE api_keys SET last_used_at = $2 FROM users
+				WHERE api_keys.key_hash = $1 AND (api_keys.expires_at IS NULL OR api_keys.expires_at > $2) AND users.id = api_keys.user_id AND users.deleted_at IS NULL
+				RETURNING api_keys.id, api_keys.name, api_keys.scopes, api_keys.created, api_keys.expires_at, api_keys.last_used_at, api_keys.user_id`, HashAPIKey(key), now),
+			&userId,
+		)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repository.APIKeyNotFound
+			}
+			return err
+		}
+		user, err = r.GetUserWithTx(ctx,
+			`SELECT id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience, version, email_verified, prefilled_fields FROM users WHERE id = $1 AND deleted_at IS NULL LIMIT 1`,
+			tx,
+			userId,
+		)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, apiKey, nil
+}
+
+// ScanAPIKey scans the id, name, scopes, created, expires_at and last_used_at columns
+// into a model.APIKey, any extra destinations are scanned from the columns following them
+func ScanAPIKey[T Scannable](scannable T, extra ...interface{}) (*model.APIKey, error) {
+	var apiKey model.APIKey
+	var apiKeyId int
+	var scopes []string
+	dest := append([]interface{}{
+		&apiKeyId,
+		&apiKey.Name,
+		&scopes,
+		&apiKey.Created,
+		&apiKey.ExpiresAt,
+		&apiKey.LastUsedAt,
+	}, extra...)
+	err := scannable.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+	apiKey.ID = strconv.Itoa(apiKeyId)
+	apiKey.Scopes = make([]model.APIKeyScope, 0, len(scopes))
+	for _, scope := range scopes {
+		apiKey.Scopes = append(apiKey.Scopes, model.APIKeyScope(scope))
+	}
+	return &apiKey, nil
+}
@@ -0,0 +1,270 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/KnightHacks/knighthacks_shared/utils"
+	"github.com/KnightHacks/knighthacks_users/cursor"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/jackc/pgx/v5"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Redacted replaces the before and after values of personal information in the audit log,
+// the log only records that the field changed
+const Redacted = "REDACTED"
+
+// redactedFields are the audited fields that contain personal information, the audit log is
+// kept after a user is erased so none of it may be written unredacted
+var redactedFields = map[string]bool{
+	"firstName":                    true,
+	"lastName":                     true,
+	"email":                        true,
+	"phoneNumber":                  true,
+	"age":                          true,
+	"pronouns":                     true,
+	"gender":                       true,
+	"race":                         true,
+	"mailingAddress.country":       true,
+	"mailingAddress.state":         true,
+	"mailingAddress.city":          true,
+	"mailingAddress.postalCode":    true,
+	"mailingAddress.addressLines":  true,
+	"educationInfo.name":           true,
+	"educationInfo.major":          true,
+	"educationInfo.graduationDate": true,
+	"educationInfo.level":          true,
+}
+
+// auditField is the value of a single field of the user at a point in time
+type auditField struct {
+	name  string
+	value *string
+}
+
+// InsertAuditLogEntry records a change made to the user, it must be called with the same
+// transaction as the change so the entry is only written if the change is. The actor and
+// request id are taken from the context, see repository.WithActor.
+func (r *DatabaseRepository) InsertAuditLogEntry(ctx context.Context, tx pgx.Tx, userId string, operation model.AuditOperation, changes []*model.FieldChange) error {
+	actor := repository.ActorFromContext(ctx)
+	var actorId, actorRole, requestId *string
+	if actor.UserID != "" {
+		actorId = &actor.UserID
+		actorRole = utils.Ptr(actor.Role.String())
+	}
+	if actor.RequestID != "" {
+		requestId = &actor.RequestID
+	}
+	if changes == nil {
+		changes = []*model.FieldChange{}
+	}
+	marshalledChanges, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO audit_log (actor_id, actor_role, user_id, operation, changes, request_id, created) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		actorId,
+		actorRole,
+		userId,
+		operation.String(),
+		string(marshalledChanges),
+		requestId,
+		time.Now().UTC(),
+	)
+	return err
+}
+
+// auditLogSort is the sort of the audit log's cursors, the entries are always newest first so
+// cursors of the users query can't be used with it
+const auditLogSort = "AUDIT_LOG"
+
+// GetAuditLog returns a page of the user's audit log newest first, after is the end cursor of
+// the previous page
+func (r *DatabaseRepository) GetAuditLog(ctx context.Context, userId string, first int, after *cursor.Cursor) (*model.AuditLogConnection, error) {
+	args := []interface{}{userId, first + 1}
+	query := "SELECT id, actor_id, actor_role, user_id, operation, changes, request_id, created FROM audit_log WHERE user_id = $1"
+	var afterId int
+	if after != nil {
+		var err error
+		if afterId, err = strconv.Atoi(after.ID); err != nil || after.Sort != auditLogSort {
+			return nil, cursor.NotValid
+		}
+		query += " AND id < $3"
+		args = append(args, afterId)
+	}
+
+	connection := &model.AuditLogConnection{PageInfo: &model.UsersPageInfo{}}
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// one more entry than asked for is fetched to know if there are more
+		rows, _ := tx.Query(ctx, query+" ORDER BY id DESC LIMIT $2", args...)
+		entries, err := pgx.CollectRows(rows, scanAuditLogEntry)
+		if err != nil {
+			return err
+		}
+		if len(entries) > first {
+			entries = entries[:first]
+			connection.PageInfo.HasNextPage = true
+		}
+		connection.Entries = entries
+
+		// the entries are only ever added to, anything before the cursor is newer than it
+		if after != nil {
+			err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM audit_log WHERE user_id = $1 AND id >= $2)", userId, afterId).Scan(&connection.PageInfo.HasPreviousPage)
+			if err != nil {
+				return err
+			}
+		}
+		return tx.QueryRow(ctx, "SELECT COUNT(*) FROM audit_log WHERE user_id = $1", userId).Scan(&connection.TotalCount)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(connection.Entries) > 0 {
+		connection.PageInfo.StartCursor = cursor.Cursor{Sort: auditLogSort, ID: connection.Entries[0].ID}.Encode()
+		connection.PageInfo.EndCursor = cursor.Cursor{Sort: auditLogSort, ID: connection.Entries[len(connection.Entries)-1].ID}.Encode()
+	}
+	return connection, nil
+}
+
+// scanAuditLogEntry scans the id, actor_id, actor_role, user_id, operation, changes, request_id
+// and created columns of the audit log, the changes were already redacted when they were written
+func scanAuditLogEntry(row pgx.CollectableRow) (*model.AuditLogEntry, error) {
+	var entry model.AuditLogEntry
+	var id, entryUserId int
+	var actorId *int
+	err := row.Scan(&id, &actorId, &entry.ActorRole, &entryUserId, &entry.Operation, &entry.Changes, &entry.RequestID, &entry.Created)
+	if err != nil {
+		return nil, err
+	}
+	entry.ID = strconv.Itoa(id)
+	entry.UserID = strconv.Itoa(entryUserId)
+	if actorId != nil {
+		entry.ActorID = utils.Ptr(strconv.Itoa(*actorId))
+	}
+	return &entry, nil
+}
+
+// auditSnapshot returns every audited field of the user, the user's row is locked so the
+// snapshot stays correct until the transaction ends
+func (r *DatabaseRepository) auditSnapshot(ctx context.Context, tx pgx.Tx, id string) ([]auditField, error) {
+	var firstName, lastName, email string
+	var phoneNumber, gender, shirtSize, country, state, city, postalCode, educationName, major, level *string
+	var pronounId, age *int
+	var yearsOfExperience *float64
+	var race, addressLines []string
+	var sendMessages, shareInfo, codeOfConduct *bool
+	var graduationDate *time.Time
+	err := tx.QueryRow(ctx, `SELECT users.first_name, users.last_name, users.email, users.phone_number, users.pronoun_id, users.age, users.gender, users.race, users.shirt_size, users.years_of_experience,
+		mailing_addresses.country, mailing_addresses.state, mailing_addresses.city, mailing_addresses.postal_code, mailing_addresses.address_lines,
+		mlh_terms.send_messages, mlh_terms.share_info, mlh_terms.code_of_conduct,
+		education_info.name, education_info.major, education_info.graduation_date, education_info.level
+		FROM users
+		LEFT JOIN mailing_addresses ON mailing_addresses.user_id = users.id
+		LEFT JOIN mlh_terms ON mlh_terms.user_id = users.id
+		LEFT JOIN education_info ON education_info.user_id = users.id
+		WHERE users.id = $1 AND users.deleted_at IS NULL FOR UPDATE OF users`, id).Scan(
+		&firstName, &lastName, &email, &phoneNumber, &pronounId, &age, &gender, &race, &shirtSize, &yearsOfExperience,
+		&country, &state, &city, &postalCode, &addressLines,
+		&sendMessages, &shareInfo, &codeOfConduct,
+		&educationName, &major, &graduationDate, &level,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var pronouns *string
+	if pronounId != nil {
+		p, err := r.GetPronouns(ctx, tx, *pronounId)
+		if err != nil {
+			return nil, err
+		}
+		if p.AskMe {
+			pronouns = utils.Ptr(p.Subjective)
+		} else {
+			pronouns = utils.Ptr(p.Subjective + "/" + p.Objective)
+		}
+	}
+
+	return []auditField{
+		{"firstName", &firstName},
+		{"lastName", &lastName},
+		{"email", &email},
+		{"phoneNumber", phoneNumber},
+		{"pronouns", pronouns},
+		{"age", auditValue(age)},
+		{"gender", gender},
+		{"race", auditList(race)},
+		{"shirtSize", shirtSize},
+		{"yearsOfExperience", auditValue(yearsOfExperience)},
+		{"mailingAddress.country", country},
+		{"mailingAddress.state", state},
+		{"mailingAddress.city", city},
+		{"mailingAddress.postalCode", postalCode},
+		{"mailingAddress.addressLines", auditList(addressLines)},
+		{"mlh.sendMessages", auditValue(sendMessages)},
+		{"mlh.shareInfo", auditValue(shareInfo)},
+		{"mlh.codeOfConduct", auditValue(codeOfConduct)},
+		{"educationInfo.name", educationName},
+		{"educationInfo.major", major},
+		{"educationInfo.graduationDate", auditTime(graduationDate)},
+		{"educationInfo.level", level},
+	}, nil
+}
+
+// diffAuditSnapshots returns every field that changed between the two snapshots of the
+// same user, personal information is redacted
+func diffAuditSnapshots(before []auditField, after []auditField) []*model.FieldChange {
+	changes := make([]*model.FieldChange, 0)
+	for i, field := range before {
+		if equalAuditValues(field.value, after[i].value) {
+			continue
+		}
+		change := &model.FieldChange{Field: field.name, Before: field.value, After: after[i].value}
+		if redactedFields[field.name] {
+			change.Before = redact(change.Before)
+			change.After = redact(change.After)
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func equalAuditValues(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func redact(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	return utils.Ptr(Redacted)
+}
+
+func auditValue[T any](value *T) *string {
+	if value == nil {
+		return nil
+	}
+	return utils.Ptr(fmt.Sprint(*value))
+}
+
+func auditList(values []string) *string {
+	if values == nil {
+		return nil
+	}
+	return utils.Ptr(strings.Join(values, ", "))
+}
+
+func auditTime(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+	return utils.Ptr(value.UTC().Format(time.RFC3339))
+}
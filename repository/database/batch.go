@@ -0,0 +1,241 @@
+package database
+
+import (
+	"context"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/jackc/pgx/v5"
+	"strconv"
+)
+
+/*
+ The batch variants of the getters, each returns a map keyed by the user's id and users that
+ have nothing stored are left out of the map. They are used by the loaders so that resolving
+ a field for a page of users is a single query instead of one per user.
+*/
+
+// GetUsersByIDs returns the users by their ids, deleted users are left out
+func (r *DatabaseRepository) GetUsersByIDs(ctx context.Context, ids []string) (map[string]*model.User, error) {
+	return r.getUsersByKey(
+		ctx,
+		`SELECT id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience, version, email_verified, prefilled_fields, id::varchar FROM users WHERE id = ANY($1::int[]) AND deleted_at IS NULL`,
+		ids,
+	)
+}
+
+// GetUsersByApplicationIDs returns the users that made each of the hackathon applications, the
+// map is keyed by the application's id
+func (r *DatabaseRepository) GetUsersByApplicationIDs(ctx context.Context, applicationIds []string) (map[string]*model.User, error) {
+	return r.getUsersByKey(
+		ctx,
+		`SELECT users.id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience, version, email_verified, prefilled_fields, hackathon_applications.id::varchar FROM users JOIN hackathon_applications ON hackathon_applications.user_id = users.id WHERE hackathon_applications.id = ANY($1::int[]) AND users.deleted_at IS NULL`,
+		applicationIds,
+	)
+}
+
+// GetUsersByOAuthIdentities returns the users that the OAuth identities are linked to, see
+// GetUserByOAuthUID
+func (r *DatabaseRepository) GetUsersByOAuthIdentities(ctx context.Context, identities []model.OAuth) (map[model.OAuth]*model.User, error) {
+	providers := make([]string, 0, len(identities))
+	uids := make([]string, 0, len(identities))
+	for _, identity := range identities {
+		providers = append(providers, identity.Provider.String())
+		uids = append(uids, identity.UID)
+	}
+	byKey, err := r.getUsersByKey(
+		ctx,
+		`SELECT users.id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience, version, email_verified, prefilled_fields, oauth_identities.provider || ':' || oauth_identities.uid
+			FROM users JOIN oauth_identities ON oauth_identities.user_id = users.id
+			JOIN unnest($1::varchar[], $2::varchar[]) AS identities (provider, uid) ON identities.provider = oauth_identities.provider AND identities.uid = oauth_identities.uid
+			WHERE oauth_identities.deleted_at IS NULL AND users.deleted_at IS NULL`,
+		providers,
+		uids,
+	)
+	if err != nil {
+		return nil, err
+	}
+	users := make(map[model.OAuth]*model.User, len(byKey))
+	for _, identity := range identities {
+		if user, ok := byKey[identity.Provider.String()+":"+identity.UID]; ok {
+			users[identity] = user
+		}
+	}
+	return users, nil
+}
+
+// getUsersByKey runs a query that selects the user's columns followed by the key the user is
+// returned under as a varchar
+func (r *DatabaseRepository) getUsersByKey(ctx context.Context, query string, args ...interface{}) (map[string]*model.User, error) {
+	users := make(map[string]*model.User)
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		pronounIds := make(map[string]int)
+		for rows.Next() {
+			var user model.User
+			var key string
+			pronounId, err := ScanUser(&user, rows, &key)
+			if err != nil {
+				rows.Close()
+				return err
+			}
+			if pronounId != nil {
+				pronounIds[key] = *pronounId
+			}
+			users[key] = &user
+		}
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		// the rows have to be read before the pronouns can be queried on the same transaction
+		for key, pronounId := range pronounIds {
+			pronouns, err := r.GetPronouns(ctx, tx, pronounId)
+			if err != nil {
+				return err
+			}
+			users[key].Pronouns = pronouns
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// GetOAuths returns the primary oauth identity of each of the users, see GetOAuth
+func (r *DatabaseRepository) GetOAuths(ctx context.Context, userIds []string) (map[string]*model.OAuth, error) {
+	rows, err := r.DatabasePool.Query(
+		ctx,
+		"SELECT DISTINCT ON (user_id) user_id, uid, provider FROM oauth_identities WHERE user_id = ANY($1::int[]) ORDER BY user_id, linked_at, provider",
+		userIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	oAuths := make(map[string]*model.OAuth, len(userIds))
+	for rows.Next() {
+		var userId int
+		var oAuth model.OAuth
+		if err = rows.Scan(&userId, &oAuth.UID, &oAuth.Provider); err != nil {
+			return nil, err
+		}
+		oAuths[strconv.Itoa(userId)] = &oAuth
+	}
+	return oAuths, rows.Err()
+}
+
+// GetMailingAddresses returns the mailing address of each of the users
+func (r *DatabaseRepository) GetMailingAddresses(ctx context.Context, userIds []string) (map[string]*model.MailingAddress, error) {
+	rows, err := r.DatabasePool.Query(
+		ctx,
+		"SELECT user_id, country, state, city, postal_code, address_lines FROM mailing_addresses WHERE user_id = ANY($1::int[])",
+		userIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	mailingAddresses := make(map[string]*model.MailingAddress, len(userIds))
+	for rows.Next() {
+		var userId int
+		var mailingAddress model.MailingAddress
+		err = rows.Scan(
+			&userId,
+			&mailingAddress.Country,
+			&mailingAddress.State,
+			&mailingAddress.City,
+			&mailingAddress.PostalCode,
+			&mailingAddress.AddressLines,
+		)
+		if err != nil {
+			return nil, err
+		}
+		mailingAddresses[strconv.Itoa(userId)] = &mailingAddress
+	}
+	return mailingAddresses, rows.Err()
+}
+
+// GetMLHTerms returns the mlh terms each of the users agreed to
+func (r *DatabaseRepository) GetMLHTerms(ctx context.Context, userIds []string) (map[string]*model.MLHTerms, error) {
+	rows, err := r.DatabasePool.Query(
+		ctx,
+		"SELECT user_id, send_messages, share_info, code_of_conduct FROM mlh_terms WHERE user_id = ANY($1::int[])",
+		userIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	mlhTerms := make(map[string]*model.MLHTerms, len(userIds))
+	for rows.Next() {
+		var userId int
+		var terms model.MLHTerms
+		if err = rows.Scan(&userId, &terms.SendMessages, &terms.ShareInfo, &terms.CodeOfConduct); err != nil {
+			return nil, err
+		}
+		mlhTerms[strconv.Itoa(userId)] = &terms
+	}
+	return mlhTerms, rows.Err()
+}
+
+// GetEducationInfos returns the education info of each of the users
+func (r *DatabaseRepository) GetEducationInfos(ctx context.Context, userIds []string) (map[string]*model.EducationInfo, error) {
+	rows, err := r.DatabasePool.Query(
+		ctx,
+		"SELECT user_id, name, major, graduation_date, level FROM education_info WHERE user_id = ANY($1::int[])",
+		userIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	educationInfos := make(map[string]*model.EducationInfo, len(userIds))
+	for rows.Next() {
+		var userId int
+		var educationInfo model.EducationInfo
+		err = rows.Scan(
+			&userId,
+			&educationInfo.Name,
+			&educationInfo.Major,
+			&educationInfo.GraduationDate,
+			&educationInfo.Level,
+		)
+		if err != nil {
+			return nil, err
+		}
+		educationInfos[strconv.Itoa(userId)] = &educationInfo
+	}
+	return educationInfos, rows.Err()
+}
+
+// GetAPIKeysByUserIDs returns the api keys of each of the users, see GetAPIKeys
+func (r *DatabaseRepository) GetAPIKeysByUserIDs(ctx context.Context, userIds []string) (map[string][]*model.APIKey, error) {
+	rows, err := r.DatabasePool.Query(
+		ctx,
+		"SELECT id, name, scopes, created, expires_at, last_used_at, user_id FROM api_keys WHERE user_id = ANY($1::int[]) ORDER BY created",
+		userIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	apiKeys := make(map[string][]*model.APIKey, len(userIds))
+	for rows.Next() {
+		var userId int
+		apiKey, err := ScanAPIKey(rows, &userId)
+		if err != nil {
+			return nil, err
+		}
+		apiKeys[strconv.Itoa(userId)] = append(apiKeys[strconv.Itoa(userId)], apiKey)
+	}
+	return apiKeys, rows.Err()
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/KnightHacks/knighthacks_shared/database"
 	sharedModels "github.com/KnightHacks/knighthacks_shared/models"
 	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/phonenumber"
 	"github.com/KnightHacks/knighthacks_users/repository"
 	"github.com/jackc/pgx/v5"
 	"strconv"
@@ -15,15 +16,29 @@ import (
 //
 // The NewUser input struct contains all nillable fields so the following function
 // must be able to run regardless of whether of it's input, that is why there is a
-// lot of pointers for nil safety purposes
-func (r *DatabaseRepository) CreateUser(ctx context.Context, oAuth *model.OAuth, input *model.NewUser) (*model.User, error) {
+// lot of pointers for nil safety purposes. prefilledFields are the fields of the profile that
+// were prefilled from the user's OAuth provider.
+func (r *DatabaseRepository) CreateUser(ctx context.Context, oAuth *model.OAuth, input *model.NewUser, prefilledFields []model.ProfileField) (*model.User, error) {
+	// phone numbers are stored in E.164 so the same number typed differently can't be used twice
+	region := phonenumber.DefaultRegion
+	if input.MailingAddress != nil {
+		region = phonenumber.RegionForCountry(input.MailingAddress.Country)
+	}
+	phoneNumber, err := phonenumber.Normalize(input.PhoneNumber, region)
+	if err != nil {
+		return nil, err
+	}
+	input.PhoneNumber = phoneNumber
+	input.Email = NormalizeEmail(input.Email)
+	if prefilledFields == nil {
+		prefilledFields = []model.ProfileField{}
+	}
+
 	var pronouns *model.Pronouns = nil
 	if input.Pronouns != nil {
-		// input.Pronouns is a PronounsInput struct which a direct copy of the Pronouns struct, so we need to copy its fields
-		pronouns = &model.Pronouns{
-			Subjective: input.Pronouns.Subjective,
-			Objective:  input.Pronouns.Objective,
-		}
+		// pronouns are stored normalized so the same pronouns typed differently aren't stored twice
+		normalized := NormalizePronouns(input.Pronouns)
+		pronouns = &normalized
 	}
 
 	user := &model.User{
@@ -39,14 +54,16 @@ func (r *DatabaseRepository) CreateUser(ctx context.Context, oAuth *model.OAuth,
 		YearsOfExperience: input.YearsOfExperience,
 		ShirtSize:         input.ShirtSize,
 		Gender:            input.Gender,
+		Version:           1,
+		PrefilledFields:   prefilledFields,
 	}
 
 	// Begins the database transaction
-	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		// Detects whether the user with the oauth_uid, for GitHub that is their github ID already exists, if
+	err = pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// Detects whether the oauth identity, for GitHub that is their github ID already exists, if
 		// the use already exists we return an UserAlreadyExists error
 		var discoveredId = new(int)
-		err := tx.QueryRow(ctx, "SELECT id FROM users WHERE oauth_uid=$1 AND oauth_provider=$2 LIMIT 1", oAuth.UID, oAuth.Provider.String()).Scan(discoveredId)
+		err := tx.QueryRow(ctx, "SELECT user_id FROM oauth_identities WHERE uid=$1 AND provider=$2 AND deleted_at IS NULL LIMIT 1", oAuth.UID, oAuth.Provider.String()).Scan(discoveredId)
 		if err == nil && discoveredId != nil {
 			return repository.UserAlreadyExists
 		}
@@ -63,10 +80,13 @@ func (r *DatabaseRepository) CreateUser(ctx context.Context, oAuth *model.OAuth,
 			}
 		}
 		// Insert new user into database
-		userIdInt, err := r.InsertUser(ctx, tx, input, pronounIdPtr, oAuth)
+		userIdInt, err := r.InsertUser(ctx, tx, input, pronounIdPtr, prefilledFields)
 		if err != nil {
 			return err
 		}
+		if err = r.InsertOAuthIdentity(ctx, tx, userIdInt, oAuth); err != nil {
+			return err
+		}
 
 		// Insert MLH Terms
 		if input.Mlh != nil {
@@ -108,7 +128,10 @@ func (r *DatabaseRepository) CreateUser(ctx context.Context, oAuth *model.OAuth,
 		}
 
 		user.ID = strconv.Itoa(userIdInt)
-		return nil
+		if err = r.InsertUserVersion(ctx, tx, user.ID); err != nil {
+			return err
+		}
+		return r.InsertAuditLogEntry(ctx, tx, user.ID, model.AuditOperationCreateUser, nil)
 	})
 	if err != nil {
 		return nil, err
@@ -117,7 +140,7 @@ func (r *DatabaseRepository) CreateUser(ctx context.Context, oAuth *model.OAuth,
 	return user, nil
 }
 
-func (r *DatabaseRepository) InsertUser(ctx context.Context, queryable database.Queryable, input *model.NewUser, pronounIdPtr *int, oAuth *model.OAuth) (int, error) {
+func (r *DatabaseRepository) InsertUser(ctx context.Context, queryable database.Queryable, input *model.NewUser, pronounIdPtr *int, prefilledFields []model.ProfileField) (int, error) {
 	// TODO: Possibly change ID type to int to stop this hacky fix?
 	// insert user into database and return their ID
 
@@ -129,20 +152,19 @@ func (r *DatabaseRepository) InsertUser(ctx context.Context, queryable database.
 		}
 	}
 	var userIdInt int
-	err := queryable.QueryRow(ctx, "INSERT INTO users (first_name, last_name, email, phone_number, age, pronoun_id, oauth_uid, oauth_provider, role, years_of_experience, shirt_size, race, gender) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING id",
+	err := queryable.QueryRow(ctx, "INSERT INTO users (first_name, last_name, email, phone_number, age, pronoun_id, role, years_of_experience, shirt_size, race, gender, prefilled_fields) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING id",
 		input.FirstName,
 		input.LastName,
 		input.Email,
 		input.PhoneNumber,
 		input.Age,
 		pronounIdPtr,
-		oAuth.UID,
-		oAuth.Provider.String(),
 		sharedModels.RoleNormal,
 		input.YearsOfExperience,
 		input.ShirtSize,
 		raceStringArray,
 		input.Gender,
+		prefilledFields,
 	).Scan(&userIdInt)
 	return userIdInt, err
 }
@@ -3,20 +3,16 @@ package database
 import (
 	"context"
 	"fmt"
-	"github.com/KnightHacks/knighthacks_users/graph/model"
-	"github.com/KnightHacks/knighthacks_users/repository"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // DatabaseRepository
 // Implements the Repository interface's functions
 //
-// PronounMap & PronounReverseMap are the 2 maps that implement a bidirectional
-// map to handle cached pronouns in the database to remove the need to do a SQL join
+// Pronouns caches the pronouns in the database to remove the need to do a SQL join
 type DatabaseRepository struct {
-	DatabasePool      *pgxpool.Pool
-	PronounMap        map[int]model.Pronouns
-	PronounReverseMap map[model.Pronouns]int
+	DatabasePool *pgxpool.Pool
+	Pronouns     *PronounStore
 }
 
 func NewDatabaseRepository(ctx context.Context, databasePool *pgxpool.Pool) (*DatabaseRepository, error) {
@@ -24,9 +20,8 @@ func NewDatabaseRepository(ctx context.Context, databasePool *pgxpool.Pool) (*Da
 		return nil, fmt.Errorf("cannot create DatabaseRepository with nil databasePool")
 	}
 	databaseRepository := &DatabaseRepository{
-		DatabasePool:      databasePool,
-		PronounMap:        map[int]model.Pronouns{},
-		PronounReverseMap: map[model.Pronouns]int{},
+		DatabasePool: databasePool,
+		Pronouns:     NewPronounStore(),
 	}
 	if err := databaseRepository.DatabasePool.Ping(ctx); err != nil {
 		return nil, err
@@ -36,22 +31,3 @@ func NewDatabaseRepository(ctx context.Context, databasePool *pgxpool.Pool) (*Da
 	}
 	return databaseRepository, nil
 }
-
-func (r *DatabaseRepository) DeleteUser(ctx context.Context, id string) (bool, error) {
-
-	//query the row using the id with a DELETE statment
-	commandTag, err := r.DatabasePool.Exec(ctx, "DELETE FROM users WHERE id = $1", id)
-
-	//err should return a nil value, if not throw error
-	if err != nil {
-		return false, err
-	}
-
-	//there should be one row affected, if not throw error
-	if commandTag.RowsAffected() != 1 {
-		return false, repository.UserNotFound
-	}
-
-	// then no error
-	return true, nil
-}
@@ -0,0 +1,203 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/jackc/pgx/v5"
+	"log"
+	"strconv"
+	"time"
+)
+
+// erasedTables are every table with rows tied to a user that are deleted when the user
+// is erased, the users row itself is always deleted last
+var erasedTables = []string{
+	"oauth_identities",
+	"mailing_addresses",
+	"mlh_terms",
+	"education_info",
+	"api_keys",
+	"sessions",
+	"role_changes",
+	"user_versions",
+	"email_changes",
+	"email_verifications",
+	"hackathon_applications",
+	"meals",
+}
+
+// anonymizedTables are tables whose rows are kept without the user's id when the user is
+// erased, so aggregates such as the amount of check-ins per hackathon stay correct
+var anonymizedTables = []struct {
+	table          string
+	anonymousTable string
+	columns        string
+}{
+	{"hackathon_checkin", "anonymous_hackathon_checkin", "hackathon_id, time"},
+	{"event_attendance", "anonymous_event_attendance", "event_id, time"},
+}
+
+// DeleteUser erases the user along with every row tied to them inside a single transaction,
+// the returned report contains the amount of rows removed and anonymized from each table.
+// Soft deleted users can also be erased, this is how they are purged. The last admin can't be erased.
+func (r *DatabaseRepository) DeleteUser(ctx context.Context, id string) (*model.DeletionReport, error) {
+	report := &model.DeletionReport{
+		Deleted:    make([]*model.TableRowCount, 0),
+		Anonymized: make([]*model.TableRowCount, 0),
+	}
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if err := r.checkNotLastAdmin(ctx, tx, id); err != nil {
+			return err
+		}
+		var userId int
+		err := tx.QueryRow(ctx, "SELECT id FROM users WHERE id = $1 FOR UPDATE", id).Scan(&userId)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repository.UserNotFound
+			}
+			return err
+		}
+
+		for _, anonymized := range anonymizedTables {
+			commandTag, err := tx.Exec(ctx, fmt.Sprintf("INSERT INTO %[1]s (%[2]s) SELECT %[2]s FROM %[3]s WHERE user_id = $1", anonymized.anonymousTable, anonymized.columns, anonymized.table), userId)
+			if err != nil {
+				return err
+			}
+			if commandTag.RowsAffected() > 0 {
+				report.Anonymized = append(report.Anonymized, &model.TableRowCount{Table: anonymized.table, Rows: int(commandTag.RowsAffected())})
+			}
+			if _, err = tx.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE user_id = $1", anonymized.table), userId); err != nil {
+				return err
+			}
+		}
+
+		for _, table := range erasedTables {
+			commandTag, err := tx.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE user_id = $1", table), userId)
+			if err != nil {
+				return err
+			}
+			if commandTag.RowsAffected() > 0 {
+				report.Deleted = append(report.Deleted, &model.TableRowCount{Table: table, Rows: int(commandTag.RowsAffected())})
+			}
+		}
+
+		// role changes the user made to other users are kept, changed_by is set to null by the foreign key
+		if _, err = tx.Exec(ctx, "DELETE FROM users WHERE id = $1", userId); err != nil {
+			return err
+		}
+		report.Deleted = append(report.Deleted, &model.TableRowCount{Table: "users", Rows: 1})
+		// the audit log is kept, the personal information in it was redacted when it was written
+		return r.InsertAuditLogEntry(ctx, tx, id, model.AuditOperationEraseUser, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// SoftDeleteUser marks the user as deleted and logs out all of their sessions, the user is
+// hidden everywhere but none of their rows are removed until they are purged. Their OAuth
+// identities are released so they can register again, the last admin can't be deleted.
+func (r *DatabaseRepository) SoftDeleteUser(ctx context.Context, id string) (time.Time, error) {
+	deletedAt := time.Now().UTC()
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if err := r.checkNotLastAdmin(ctx, tx, id); err != nil {
+			return err
+		}
+		commandTag, err := tx.Exec(ctx, "UPDATE users SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL", deletedAt, id)
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return repository.UserNotFound
+		}
+		_, err = tx.Exec(ctx, "UPDATE sessions SET revoked_at = $1 WHERE user_id = $2 AND revoked_at IS NULL", deletedAt, id)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, "UPDATE oauth_identities SET deleted_at = $1 WHERE user_id = $2", deletedAt, id); err != nil {
+			return err
+		}
+		return r.InsertAuditLogEntry(ctx, tx, id, model.AuditOperationDeleteUser, nil)
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return deletedAt, nil
+}
+
+// RestoreUser undoes a soft delete, only users deleted after deletedAfter can be restored. The
+// user can't be restored if one of their OAuth identities has since registered another user.
+func (r *DatabaseRepository) RestoreUser(ctx context.Context, id string, deletedAfter time.Time) (*model.User, error) {
+	var user *model.User
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var deletedAt *time.Time
+		err := tx.QueryRow(ctx, "SELECT deleted_at FROM users WHERE id = $1 FOR UPDATE", id).Scan(&deletedAt)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repository.UserNotFound
+			}
+			return err
+		}
+		if deletedAt == nil {
+			return repository.UserNotDeleted
+		}
+		if !deletedAt.After(deletedAfter) {
+			return repository.RestoreWindowExpired
+		}
+		var identityTaken bool
+		err = tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM oauth_identities deleted JOIN oauth_identities active
+			ON active.provider = deleted.provider AND active.uid = deleted.uid AND active.deleted_at IS NULL
+			WHERE deleted.user_id = $1)`, id).Scan(&identityTaken)
+		if err != nil {
+			return err
+		}
+		if identityTaken {
+			return repository.OAuthIdentityAlreadyLinked
+		}
+		if _, err = tx.Exec(ctx, "UPDATE oauth_identities SET deleted_at = NULL WHERE user_id = $1", id); err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, "UPDATE users SET deleted_at = NULL WHERE id = $1", id); err != nil {
+			return err
+		}
+		if err = r.bumpUserVersion(ctx, tx, id); err != nil {
+			return err
+		}
+		if err = r.InsertAuditLogEntry(ctx, tx, id, model.AuditOperationRestoreUser, nil); err != nil {
+			return err
+		}
+		user, err = r.getUserByIdWithTx(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// PurgeDeletedUsers erases every user that was soft deleted before deletedBefore and
+// returns how many users were erased, each user is erased in their own transaction. A user
+// that can't be erased doesn't stop the others from being erased, the errors of every user
+// that failed are joined.
+func (r *DatabaseRepository) PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time) (int, error) {
+	rows, _ := r.DatabasePool.Query(ctx, "SELECT id FROM users WHERE deleted_at < $1 ORDER BY id", deletedBefore)
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int])
+	if err != nil {
+		return 0, err
+	}
+	var purged int
+	var errs []error
+	for _, id := range ids {
+		if _, err = r.DeleteUser(ctx, strconv.Itoa(id)); err != nil {
+			log.Printf("unable to purge deleted user %d err = %v\n", id, err)
+			errs = append(errs, fmt.Errorf("user %d: %w", id, err))
+			continue
+		}
+		purged++
+	}
+	return purged, errors.Join(errs...)
+}
@@ -0,0 +1,151 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/jackc/pgx/v5"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// emailChangeTokenLength is the amount of random bytes in the token sent to confirm a new email
+const emailChangeTokenLength = 32
+
+// NormalizeEmail returns the email as it is stored, emails are compared case-insensitively
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// CreateEmailChange starts changing the user's email, the change is only applied once the
+// returned token is passed to ConfirmEmailChange. Only the latest change of a user can be
+// confirmed. repository.EmailUnchanged is returned if the user already has the email.
+func (r *DatabaseRepository) CreateEmailChange(ctx context.Context, userId string, newEmail string, expiresAt time.Time) (string, error) {
+	var token string
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var err error
+		token, err = r.createEmailChange(ctx, tx, userId, newEmail, expiresAt)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// createEmailChange is CreateEmailChange within the caller's transaction
+func (r *DatabaseRepository) createEmailChange(ctx context.Context, tx pgx.Tx, userId string, newEmail string, expiresAt time.Time) (string, error) {
+	newEmail = NormalizeEmail(newEmail)
+	token, err := GenerateToken(emailChangeTokenLength)
+	if err != nil {
+		return "", err
+	}
+	var currentEmail string
+	err = tx.QueryRow(ctx, "SELECT email FROM users WHERE id = $1 AND deleted_at IS NULL FOR UPDATE", userId).Scan(&currentEmail)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", repository.UserNotFound
+		}
+		return "", err
+	}
+	if NormalizeEmail(currentEmail) == newEmail {
+		return "", repository.EmailUnchanged
+	}
+	var inUse bool
+	if err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM users WHERE lower(email) = $1)", newEmail).Scan(&inUse); err != nil {
+		return "", err
+	}
+	if inUse {
+		return "", repository.EmailAlreadyInUse
+	}
+
+	if _, err = tx.Exec(ctx, "DELETE FROM email_changes WHERE user_id = $1 AND confirmed_at IS NULL", userId); err != nil {
+		return "", err
+	}
+	_, err = tx.Exec(ctx, "INSERT INTO email_changes (user_id, new_email, token_hash, created, expires_at) VALUES ($1, $2, $3, $4, $5)",
+		userId,
+		newEmail,
+		HashToken(token),
+		time.Now().UTC(),
+		expiresAt.UTC(),
+	)
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// ConfirmEmailChange applies the email change the token was sent for, a token can only be
+// used once and not after it expires
+func (r *DatabaseRepository) ConfirmEmailChange(ctx context.Context, token string) (*model.User, error) {
+	var user *model.User
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var changeId, userIdInt int
+		var newEmail string
+		err := tx.QueryRow(ctx, "SELECT id, user_id, new_email FROM email_changes WHERE token_hash = $1 AND confirmed_at IS NULL AND expires_at > $2 FOR UPDATE",
+			HashToken(token),
+			time.Now().UTC(),
+		).Scan(&changeId, &userIdInt, &newEmail)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repository.EmailChangeNotValid
+			}
+			return err
+		}
+		userId := strconv.Itoa(userIdInt)
+
+		before, err := r.auditSnapshot(ctx, tx, userId)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repository.UserNotFound
+			}
+			return err
+		}
+		if err = r.UpdateEmail(ctx, userId, &newEmail, tx); err != nil {
+			return err
+		}
+		// following the link sent to the new email proves the user owns it
+		if _, err = tx.Exec(ctx, "UPDATE users SET email_verified = true WHERE id = $1", userId); err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, "UPDATE email_changes SET confirmed_at = $1 WHERE id = $2", time.Now().UTC(), changeId); err != nil {
+			return err
+		}
+		if err = r.bumpUserVersion(ctx, tx, userId); err != nil {
+			return err
+		}
+		after, err := r.auditSnapshot(ctx, tx, userId)
+		if err != nil {
+			return err
+		}
+		if err = r.InsertAuditLogEntry(ctx, tx, userId, model.AuditOperationUpdateUser, diffAuditSnapshots(before, after)); err != nil {
+			return err
+		}
+
+		user, err = r.getUserByIdWithTx(ctx, tx, userId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// GetPendingEmail returns the email the user is changing to, nil if the user isn't
+// changing their email
+func (r *DatabaseRepository) GetPendingEmail(ctx context.Context, userId string) (*string, error) {
+	var pendingEmail string
+	err := r.DatabasePool.QueryRow(ctx, "SELECT new_email FROM email_changes WHERE user_id = $1 AND confirmed_at IS NULL AND expires_at > $2 ORDER BY created DESC LIMIT 1",
+		userId,
+		time.Now().UTC(),
+	).Scan(&pendingEmail)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &pendingEmail, nil
+}
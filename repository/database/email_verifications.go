@@ -0,0 +1,121 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/jackc/pgx/v5"
+	"strconv"
+	"time"
+)
+
+// emailVerificationTokenLength is the amount of random bytes in the token sent to verify an email
+const emailVerificationTokenLength = 32
+
+// MarkEmailVerified verifies the user's email without sending them a token, used when a
+// trusted source like an OAuth provider has already verified it. Nothing is changed and false
+// is returned if the user's email is no longer email.
+func (r *DatabaseRepository) MarkEmailVerified(ctx context.Context, userId string, email string) (bool, error) {
+	commandTag, err := r.DatabasePool.Exec(ctx, "UPDATE users SET email_verified = true WHERE id = $1 AND lower(email) = $2 AND deleted_at IS NULL",
+		userId,
+		NormalizeEmail(email),
+	)
+	if err != nil {
+		return false, err
+	}
+	return commandTag.RowsAffected() == 1, nil
+}
+
+// CreateEmailVerification creates a token that verifies the user's current email when passed
+// to VerifyEmail, the email it was created for is returned with it. Creating a new token
+// invalidates the previous ones. repository.EmailAlreadyVerified is returned if there is
+// nothing to verify.
+func (r *DatabaseRepository) CreateEmailVerification(ctx context.Context, userId string, expiresAt time.Time) (string, string, error) {
+	token, err := GenerateToken(emailVerificationTokenLength)
+	if err != nil {
+		return "", "", err
+	}
+	var email string
+	err = pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var verified bool
+		err := tx.QueryRow(ctx, "SELECT email, email_verified FROM users WHERE id = $1 AND deleted_at IS NULL FOR UPDATE", userId).Scan(&email, &verified)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repository.UserNotFound
+			}
+			return err
+		}
+		if verified {
+			return repository.EmailAlreadyVerified
+		}
+
+		if _, err = tx.Exec(ctx, "DELETE FROM email_verifications WHERE user_id = $1", userId); err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, "INSERT INTO email_verifications (user_id, email, token_hash, created, expires_at) VALUES ($1, $2, $3, $4, $5)",
+			userId,
+			NormalizeEmail(email),
+			HashToken(token),
+			time.Now().UTC(),
+			expiresAt.UTC(),
+		)
+		return err
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return token, email, nil
+}
+
+// VerifyEmail verifies the email the token was sent to, a token can only be used once, not
+// after it expires and not after the user has changed their email
+func (r *DatabaseRepository) VerifyEmail(ctx context.Context, token string) (*model.User, error) {
+	var user *model.User
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var verificationId, userIdInt int
+		var email string
+		err := tx.QueryRow(ctx, "SELECT id, user_id, email FROM email_verifications WHERE token_hash = $1 AND expires_at > $2 FOR UPDATE",
+			HashToken(token),
+			time.Now().UTC(),
+		).Scan(&verificationId, &userIdInt, &email)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repository.EmailVerificationNotValid
+			}
+			return err
+		}
+		userId := strconv.Itoa(userIdInt)
+
+		if _, err = tx.Exec(ctx, "DELETE FROM email_verifications WHERE id = $1", verificationId); err != nil {
+			return err
+		}
+		commandTag, err := tx.Exec(ctx, "UPDATE users SET email_verified = true WHERE id = $1 AND lower(email) = $2 AND deleted_at IS NULL", userId, email)
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return repository.EmailVerificationNotValid
+		}
+
+		user, err = r.getUserByIdWithTx(ctx, tx, userId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// IsEmailVerified returns whether the user has verified their current email
+func (r *DatabaseRepository) IsEmailVerified(ctx context.Context, userId string) (bool, error) {
+	var verified bool
+	err := r.DatabasePool.QueryRow(ctx, "SELECT email_verified FROM users WHERE id = $1 AND deleted_at IS NULL", userId).Scan(&verified)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, repository.UserNotFound
+		}
+		return false, err
+	}
+	return verified, nil
+}
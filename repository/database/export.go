@@ -0,0 +1,156 @@
+package database
+
+import (
+	"context"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/jackc/pgx/v5"
+	"strconv"
+)
+
+// GetUserData returns every row tied to the user, the rows are all read from the same
+// snapshot so the export is consistent even if the user is being changed at the same time
+func (r *DatabaseRepository) GetUserData(ctx context.Context, userId string) (*model.UserData, error) {
+	var data model.UserData
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}, func(tx pgx.Tx) error {
+		var err error
+		data.User, err = r.getUserByIdWithTx(ctx, tx, userId)
+		if err != nil {
+			return err
+		}
+
+		rows, _ := tx.Query(ctx, "SELECT uid, provider FROM oauth_identities WHERE user_id = $1 ORDER BY linked_at, provider", userId)
+		data.OAuthIdentities, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.OAuth, error) {
+			var oAuth model.OAuth
+			return &oAuth, row.Scan(&oAuth.UID, &oAuth.Provider)
+		})
+		if err != nil {
+			return err
+		}
+
+		if data.MailingAddress, err = getMailingAddressWithTx(ctx, tx, userId); err != nil {
+			return err
+		}
+		if data.MLHTerms, err = getMLHTermsWithTx(ctx, tx, userId); err != nil {
+			return err
+		}
+		if data.EducationInfo, err = getEducationInfoWithTx(ctx, tx, userId); err != nil {
+			return err
+		}
+		if data.Versions, err = getUserVersionsWithTx(ctx, tx, userId); err != nil {
+			return err
+		}
+
+		rows, _ = tx.Query(ctx, "SELECT id, name, scopes, created, expires_at, last_used_at FROM api_keys WHERE user_id = $1 ORDER BY created", userId)
+		data.APIKeys, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.APIKey, error) {
+			return ScanAPIKey(row)
+		})
+		if err != nil {
+			return err
+		}
+
+		rows, _ = tx.Query(ctx, "SELECT new_email, created, expires_at, confirmed_at FROM email_changes WHERE user_id = $1 ORDER BY created", userId)
+		data.EmailChanges, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.EmailChangeRow, error) {
+			var emailChange model.EmailChangeRow
+			return &emailChange, row.Scan(&emailChange.NewEmail, &emailChange.Created, &emailChange.ExpiresAt, &emailChange.ConfirmedAt)
+		})
+		if err != nil {
+			return err
+		}
+
+		rows, _ = tx.Query(ctx, "SELECT email, created, expires_at FROM email_verifications WHERE user_id = $1 ORDER BY created", userId)
+		data.EmailVerifications, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.EmailVerificationRow, error) {
+			var emailVerification model.EmailVerificationRow
+			return &emailVerification, row.Scan(&emailVerification.Email, &emailVerification.Created, &emailVerification.ExpiresAt)
+		})
+		if err != nil {
+			return err
+		}
+
+		rows, _ = tx.Query(ctx, "SELECT id, user_agent, created, last_used FROM sessions WHERE user_id = $1 ORDER BY created", userId)
+		data.Sessions, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.Session, error) {
+			var session model.Session
+			var sessionId int
+			err := row.Scan(&sessionId, &session.UserAgent, &session.Created, &session.LastUsed)
+			session.ID = strconv.Itoa(sessionId)
+			return &session, err
+		})
+		if err != nil {
+			return err
+		}
+
+		rows, _ = tx.Query(ctx, "SELECT previous_role, role, changed_at FROM role_changes WHERE user_id = $1 ORDER BY changed_at, id", userId)
+		data.RoleHistory, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.RoleChange, error) {
+			var roleChange model.RoleChange
+			return &roleChange, row.Scan(&roleChange.PreviousRole, &roleChange.Role, &roleChange.ChangedAt)
+		})
+		if err != nil {
+			return err
+		}
+
+		rows, _ = tx.Query(ctx, "SELECT id, actor_id, actor_role, user_id, operation, changes, request_id, created FROM audit_log WHERE user_id = $1 ORDER BY id", userId)
+		if data.AuditLog, err = pgx.CollectRows(rows, scanAuditLogEntry); err != nil {
+			return err
+		}
+
+		rows, _ = tx.Query(ctx, `SELECT id, hackathon_id, why_attend, what_do_you_want_to_learn, share_info_with_sponsors, application_status, created_time, status_change_time
+			FROM hackathon_applications WHERE user_id = $1 ORDER BY created_time`, userId)
+		data.HackathonApplications, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.HackathonApplicationRow, error) {
+			var application model.HackathonApplicationRow
+			var applicationId, hackathonId int
+			err := row.Scan(
+				&applicationId,
+				&hackathonId,
+				&application.WhyAttend,
+				&application.WhatDoYouWantToLearn,
+				&application.ShareInfoWithSponsors,
+				&application.ApplicationStatus,
+				&application.CreatedTime,
+				&application.StatusChangeTime,
+			)
+			application.ID = strconv.Itoa(applicationId)
+			application.HackathonID = strconv.Itoa(hackathonId)
+			return &application, err
+		})
+		if err != nil {
+			return err
+		}
+
+		rows, _ = tx.Query(ctx, "SELECT hackathon_id, time FROM hackathon_checkin WHERE user_id = $1 ORDER BY time", userId)
+		data.HackathonCheckIns, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.HackathonCheckInRow, error) {
+			var checkIn model.HackathonCheckInRow
+			var hackathonId int
+			err := row.Scan(&hackathonId, &checkIn.Time)
+			checkIn.HackathonID = strconv.Itoa(hackathonId)
+			return &checkIn, err
+		})
+		if err != nil {
+			return err
+		}
+
+		rows, _ = tx.Query(ctx, "SELECT event_id, time FROM event_attendance WHERE user_id = $1 ORDER BY time", userId)
+		data.EventAttendance, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.EventAttendanceRow, error) {
+			var attendance model.EventAttendanceRow
+			var eventId int
+			err := row.Scan(&eventId, &attendance.Time)
+			attendance.EventID = strconv.Itoa(eventId)
+			return &attendance, err
+		})
+		if err != nil {
+			return err
+		}
+
+		rows, _ = tx.Query(ctx, "SELECT hackathon_id, meals FROM meals WHERE user_id = $1 ORDER BY hackathon_id", userId)
+		data.Meals, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.MealsRow, error) {
+			var meals model.MealsRow
+			var hackathonId int
+			err := row.Scan(&hackathonId, &meals.Meals)
+			meals.HackathonID = strconv.Itoa(hackathonId)
+			return &meals, err
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
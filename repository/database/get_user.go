@@ -3,7 +3,6 @@ package database
 import (
 	"context"
 	"errors"
-	sharedModels "github.com/KnightHacks/knighthacks_shared/models"
 	"github.com/KnightHacks/knighthacks_users/graph/model"
 	"github.com/KnightHacks/knighthacks_users/repository"
 	"github.com/jackc/pgx/v5"
@@ -20,64 +19,21 @@ $$ |  $$ |$$   ____| $$ |$$\       $$ |  $$ | \____$$\ $$   ____|$$ |
  \______/  \_______|  \____/        \______/ \_______/  \_______|\__|
 */
 
-func (r *DatabaseRepository) GetUsers(ctx context.Context, first int, after string) ([]*model.User, int, error) {
-	users := make([]*model.User, 0, first)
-	var totalCount int
-	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		rows, err := tx.Query(
-			ctx,
-			"SELECT id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience FROM users WHERE id > $1 ORDER BY `id` DESC LIMIT $2",
-			after,
-			first,
-		)
-		if err != nil {
-			return err
-		}
-		for rows.Next() {
-			var user model.User
-
-			pronounId, err := ScanUser(&user, rows)
-			if err != nil {
-				return err
-			}
-			// user has pronouns, but we don't know what they are
-			if pronounId != nil {
-				pronouns, err := r.GetPronouns(ctx, tx, *pronounId)
-				if err != nil {
-					return err
-				}
-				user.Pronouns = pronouns
-			}
-			users = append(users, &user)
-		}
-
-		if err = rows.Err(); err != nil {
-			return err
-		}
-		row := tx.QueryRow(ctx, "SELECT COUNT(*) FROM users")
-		if err != nil {
-			return err
-		}
-
-		return row.Scan(&totalCount)
-	})
-	return users, totalCount, err
-}
-
 // GetUserByID returns the user by their id
 func (r *DatabaseRepository) GetUserByID(ctx context.Context, id string) (*model.User, error) {
 	return r.GetUser(
 		ctx,
-		`SELECT id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience FROM users WHERE id = $1 LIMIT 1`,
+		`SELECT id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience, version, email_verified, prefilled_fields FROM users WHERE id = $1 AND deleted_at IS NULL LIMIT 1`,
 		id,
 	)
 }
 
-// GetUserByOAuthUID returns the user by their oauth auth token
-func (r *DatabaseRepository) GetUserByOAuthUID(ctx context.Context, oAuthUID string, provider sharedModels.Provider) (*model.User, error) {
+// GetUserByOAuthUID returns the user that has the oauth identity linked, any of the
+// user's linked identities can be used
+func (r *DatabaseRepository) GetUserByOAuthUID(ctx context.Context, oAuthUID string, provider model.OAuthProvider) (*model.User, error) {
 	return r.GetUser(
 		ctx,
-		`SELECT id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience FROM users WHERE oauth_uid=cast($1 as varchar) AND oauth_provider=$2 LIMIT 1`,
+		`SELECT users.id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience, version, email_verified, prefilled_fields FROM users JOIN oauth_identities ON oauth_identities.user_id = users.id WHERE oauth_identities.uid=cast($1 as varchar) AND oauth_identities.provider=$2 AND oauth_identities.deleted_at IS NULL AND users.deleted_at IS NULL LIMIT 1`,
 		oAuthUID,
 		provider,
 	)
@@ -124,7 +80,7 @@ func (r *DatabaseRepository) SearchUser(ctx context.Context, name string) ([]*mo
 	users := make([]*model.User, 0, limit)
 
 	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		rows, err := tx.Query(ctx, "SELECT id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience from users WHERE to_tsvector(first_name || ' ' || last_name) @@ to_tsquery('$1:*') LIMIT $2", name, limit)
+		rows, err := tx.Query(ctx, "SELECT id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience, version, email_verified, prefilled_fields from users WHERE to_tsvector(first_name || ' ' || last_name) @@ to_tsquery('$1:*') AND deleted_at IS NULL LIMIT $2", name, limit)
 		if err != nil {
 			return err
 		}
@@ -155,12 +111,13 @@ func (r *DatabaseRepository) SearchUser(ctx context.Context, name string) ([]*mo
 	return users, nil
 }
 
-// GetOAuth returns the model.OAuth object that is associated with the user's id
+// GetOAuth returns the primary model.OAuth object that is associated with the user's id,
+// this is the identity that was linked first.
 // Used by the OAuth force resolver, this is not a common operation so making this
 // a force resolver is a good idea
 func (r *DatabaseRepository) GetOAuth(ctx context.Context, userId string) (*model.OAuth, error) {
 	var oAuth model.OAuth
-	err := r.DatabasePool.QueryRow(ctx, "SELECT oauth_uid, oauth_provider FROM users WHERE id = $1", userId).Scan(&oAuth.UID, &oAuth.Provider)
+	err := r.DatabasePool.QueryRow(ctx, "SELECT uid, provider FROM oauth_identities WHERE user_id = $1 ORDER BY linked_at, provider LIMIT 1", userId).Scan(&oAuth.UID, &oAuth.Provider)
 	if err != nil {
 		return nil, err
 	}
@@ -202,19 +159,6 @@ func (r *DatabaseRepository) GetUserMLHTerms(ctx context.Context, userId string)
 
 }
 
-func (r *DatabaseRepository) GetAPIKey(ctx context.Context, userId string) (apiKey *model.APIKey, err error) {
-	apiKey = &model.APIKey{}
-	err = r.DatabasePool.QueryRow(
-		ctx,
-		"SELECT key, created FROM api_keys WHERE user_id = $1",
-		userId,
-	).Scan(&apiKey.Key, &apiKey.Created)
-	if err != nil {
-		return nil, err
-	}
-	return apiKey, nil
-}
-
 func (r *DatabaseRepository) GetUserEducationInfo(ctx context.Context, userId string) (*model.EducationInfo, error) {
 	var educationInfo model.EducationInfo
 	err := r.DatabasePool.QueryRow(ctx, `SELECT name, major, graduation_date, level FROM education_info WHERE user_id = $1`, userId).Scan(
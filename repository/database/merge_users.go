@@ -0,0 +1,265 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/jackc/pgx/v5"
+	"strconv"
+)
+
+// DuplicateNameSimilarity is the minimum trigram similarity between two full names for
+// the users to be considered duplicates
+const DuplicateNameSimilarity = 0.6
+
+// The expressions users are compared by when looking for duplicates, %[1]s is the table's alias.
+// Emails are compared without any +tag and phone numbers by their last 10 digits. They must stay
+// the same as the expressions of the users_duplicate_* indexes or the indexes won't be used.
+const (
+	duplicateEmail       = `regexp_replace(lower(trim(%[1]s.email)), '\+[^@]*@', '@')`
+	duplicatePhoneNumber = `nullif(right(regexp_replace(coalesce(%[1]s.phone_number, ''), '[^0-9]', '', 'g'), 10), '')`
+	duplicateName        = `lower(%[1]s.first_name || ' ' || %[1]s.last_name)`
+)
+
+// mergedTables are the tables that reference a user where the user can only have a
+// single row per key column, an empty key column means the user can only have one row.
+// When merging, a row of the merged user is only moved if the kept user doesn't already
+// have a row with the same key, otherwise it is dropped. Rows of tables that refuse
+// conflicts are never dropped, the merge is refused with a MergeConflictError instead.
+var mergedTables = []struct {
+	table           string
+	keyColumn       string
+	refuseConflicts bool
+}{
+	{"mailing_addresses", "", false},
+	{"mlh_terms", "", false},
+	{"education_info", "", false},
+	{"oauth_identities", "provider", false},
+	{"hackathon_applications", "hackathon_id", true},
+	{"hackathon_checkin", "hackathon_id", true},
+	{"event_attendance", "event_id", true},
+	{"meals", "hackathon_id", false},
+}
+
+// GetDuplicateUsers returns pairs of users that are likely the same person, users are
+// compared by their normalized email, normalized phone number and the similarity of their names
+func (r *DatabaseRepository) GetDuplicateUsers(ctx context.Context, limit int) ([]*model.DuplicateUsers, error) {
+	duplicates := make([]*model.DuplicateUsers, 0)
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// % compares the names by the trigram similarity threshold, it is the operator the
+		// name index supports where similarity() would have to be computed for every pair
+		_, err := tx.Exec(ctx, "SELECT set_config('pg_trgm.similarity_threshold', $1, true)", strconv.FormatFloat(DuplicateNameSimilarity, 'f', -1, 64))
+		if err != nil {
+			return err
+		}
+		rows, err := tx.Query(ctx, fmt.Sprintf(`SELECT a.id, b.id, %[1]s = %[2]s, coalesce(%[3]s = %[4]s, false), %[5]s %% %[6]s
+			FROM users a JOIN users b ON a.id < b.id
+			WHERE a.deleted_at IS NULL AND b.deleted_at IS NULL AND (%[1]s = %[2]s OR %[3]s = %[4]s OR %[5]s %% %[6]s)
+			ORDER BY a.id, b.id LIMIT $1`,
+			fmt.Sprintf(duplicateEmail, "a"), fmt.Sprintf(duplicateEmail, "b"),
+			fmt.Sprintf(duplicatePhoneNumber, "a"), fmt.Sprintf(duplicatePhoneNumber, "b"),
+			fmt.Sprintf(duplicateName, "a"), fmt.Sprintf(duplicateName, "b"),
+		), limit)
+		if err != nil {
+			return err
+		}
+
+		type pair struct {
+			userId, duplicateId           int
+			sameEmail, samePhone, similar bool
+		}
+		pairs := make([]pair, 0)
+		for rows.Next() {
+			var p pair
+			if err = rows.Scan(&p.userId, &p.duplicateId, &p.sameEmail, &p.samePhone, &p.similar); err != nil {
+				rows.Close()
+				return err
+			}
+			pairs = append(pairs, p)
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		// the rows must be closed before the users can be queried on the same transaction
+		for _, p := range pairs {
+			duplicate := &model.DuplicateUsers{Reasons: make([]model.DuplicateReason, 0, 3)}
+			if p.sameEmail {
+				duplicate.Reasons = append(duplicate.Reasons, model.DuplicateReasonEmail)
+			}
+			if p.samePhone {
+				duplicate.Reasons = append(duplicate.Reasons, model.DuplicateReasonPhoneNumber)
+			}
+			if p.similar {
+				duplicate.Reasons = append(duplicate.Reasons, model.DuplicateReasonName)
+			}
+			if duplicate.User, err = r.getUserByIdWithTx(ctx, tx, strconv.Itoa(p.userId)); err != nil {
+				return err
+			}
+			if duplicate.Duplicate, err = r.getUserByIdWithTx(ctx, tx, strconv.Itoa(p.duplicateId)); err != nil {
+				return err
+			}
+			duplicates = append(duplicates, duplicate)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return duplicates, nil
+}
+
+// MergeUsers moves everything the merged user owns onto the kept user and then deletes
+// the merged user, the kept user's profile always wins over the merged user's. Everything
+// happens inside a single transaction so a failed merge leaves both users untouched. The last
+// admin can't be merged into another user, and users that both applied to, checked in to or
+// attended the same hackathon or event can't be merged. The merged user's API keys lose the
+// ADMIN_EXPORT scope so they can't gain the kept user's admin role.
+func (r *DatabaseRepository) MergeUsers(ctx context.Context, keepId string, mergeId string) (*model.User, error) {
+	if keepId == mergeId {
+		return nil, repository.CannotMergeSameUser
+	}
+	var user *model.User
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// the merged user is deleted so they can't be the last admin
+		if err := r.checkNotLastAdmin(ctx, tx, mergeId); err != nil {
+			return err
+		}
+		// both users are locked in the same order every time to avoid deadlocks between merges
+		rows, err := tx.Query(ctx, "SELECT id FROM users WHERE (id = $1 OR id = $2) AND deleted_at IS NULL ORDER BY id FOR UPDATE", keepId, mergeId)
+		if err != nil {
+			return err
+		}
+		var found int
+		for rows.Next() {
+			found++
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+		if found != 2 {
+			return repository.UserNotFound
+		}
+		if err = checkMergeConflicts(ctx, tx, keepId, mergeId); err != nil {
+			return err
+		}
+
+		// meals for the same hackathon are combined instead of dropped
+		_, err = tx.Exec(ctx, `UPDATE meals SET meals = ARRAY(SELECT DISTINCT unnest(meals.meals || merged.meals))
+			FROM meals merged WHERE meals.user_id = $1 AND merged.user_id = $2 AND merged.hackathon_id = meals.hackathon_id`,
+			keepId,
+			mergeId,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, merged := range mergedTables {
+			conflict := ""
+			if merged.keyColumn != "" {
+				conflict = fmt.Sprintf(" AND kept.%[1]s = %[2]s.%[1]s", merged.keyColumn, merged.table)
+			}
+			_, err = tx.Exec(ctx, fmt.Sprintf("UPDATE %[1]s SET user_id = $1 WHERE user_id = $2 AND NOT EXISTS(SELECT 1 FROM %[1]s kept WHERE kept.user_id = $1%[2]s)", merged.table, conflict),
+				keepId,
+				mergeId,
+			)
+			if err != nil {
+				return err
+			}
+			if _, err = tx.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE user_id = $1", merged.table), mergeId); err != nil {
+				return err
+			}
+		}
+
+		// the keys were created for the merged user, if the kept user is an admin CapRole would
+		// give them admin access. Keys that are left without a scope are revoked.
+		_, err = tx.Exec(ctx, "UPDATE api_keys SET user_id = $1, scopes = array_remove(scopes, $3) WHERE user_id = $2",
+			keepId,
+			mergeId,
+			model.APIKeyScopeAdminExport.String(),
+		)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, "DELETE FROM api_keys WHERE user_id = $1 AND cardinality(scopes) = 0", keepId); err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, "UPDATE role_changes SET user_id = $1 WHERE user_id = $2", keepId, mergeId); err != nil {
+			return err
+		}
+		// the merged user's versions are dropped, the kept user's history is what matters
+		if _, err = tx.Exec(ctx, "DELETE FROM user_versions WHERE user_id = $1", mergeId); err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, "DELETE FROM email_changes WHERE user_id = $1", mergeId); err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, "DELETE FROM email_verifications WHERE user_id = $1", mergeId); err != nil {
+			return err
+		}
+		// the merged user's sessions are logged out rather than moved
+		if _, err = tx.Exec(ctx, "DELETE FROM sessions WHERE user_id = $1", mergeId); err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx, "DELETE FROM users WHERE id = $1", mergeId); err != nil {
+			return err
+		}
+		// the kept user may have gained a mailing address, education info or mlh terms
+		if err = r.bumpUserVersion(ctx, tx, keepId); err != nil {
+			return err
+		}
+		err = r.InsertAuditLogEntry(ctx, tx, keepId, model.AuditOperationMergeUsers, []*model.FieldChange{
+			{Field: "mergedUserId", After: &mergeId},
+		})
+		if err != nil {
+			return err
+		}
+
+		user, err = r.getUserByIdWithTx(ctx, tx, keepId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// checkMergeConflicts returns a MergeConflictError if both users have a row with the same key
+// in a table that refuses conflicts
+func checkMergeConflicts(ctx context.Context, tx pgx.Tx, keepId string, mergeId string) error {
+	conflicts := make([]repository.MergeConflict, 0)
+	for _, merged := range mergedTables {
+		if !merged.refuseConflicts {
+			continue
+		}
+		rows, err := tx.Query(ctx, fmt.Sprintf("SELECT merged.%[2]s::varchar FROM %[1]s merged JOIN %[1]s kept ON kept.%[2]s = merged.%[2]s WHERE kept.user_id = $1 AND merged.user_id = $2 ORDER BY merged.%[2]s", merged.table, merged.keyColumn),
+			keepId,
+			mergeId,
+		)
+		if err != nil {
+			return err
+		}
+		keys, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			conflicts = append(conflicts, repository.MergeConflict{Table: merged.table, Key: key})
+		}
+	}
+	if len(conflicts) > 0 {
+		return &repository.MergeConflictError{Conflicts: conflicts}
+	}
+	return nil
+}
+
+func (r *DatabaseRepository) getUserByIdWithTx(ctx context.Context, tx pgx.Tx, id string) (*model.User, error) {
+	return r.GetUserWithTx(ctx,
+		`SELECT id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience, version, email_verified, prefilled_fields FROM users WHERE id = $1 AND deleted_at IS NULL LIMIT 1`,
+		tx,
+		id,
+	)
+}
@@ -0,0 +1,151 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/KnightHacks/knighthacks_shared/database"
+	"github.com/KnightHacks/knighthacks_shared/utils"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/jackc/pgx/v5"
+	"strconv"
+	"time"
+)
+
+// InsertOAuthIdentity links the OAuth identity to the user, an identity can only ever
+// be linked to a single user and a user can only have one identity per provider
+func (r *DatabaseRepository) InsertOAuthIdentity(ctx context.Context, queryable database.Queryable, userId int, oAuth *model.OAuth) error {
+	_, err := queryable.Exec(ctx, "INSERT INTO oauth_identities (user_id, provider, uid, linked_at) VALUES ($1, $2, $3, $4)",
+		userId,
+		oAuth.Provider.String(),
+		oAuth.UID,
+		time.Now().UTC(),
+	)
+	return err
+}
+
+// GetOAuthIdentities returns every OAuth identity linked to the user, oldest first
+func (r *DatabaseRepository) GetOAuthIdentities(ctx context.Context, userId string) ([]*model.OAuth, error) {
+	rows, err := r.DatabasePool.Query(ctx, "SELECT uid, provider FROM oauth_identities WHERE user_id = $1 ORDER BY linked_at, provider", userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	identities := make([]*model.OAuth, 0)
+	for rows.Next() {
+		var oAuth model.OAuth
+		if err = rows.Scan(&oAuth.UID, &oAuth.Provider); err != nil {
+			return nil, err
+		}
+		identities = append(identities, &oAuth)
+	}
+	return identities, rows.Err()
+}
+
+// LinkOAuthIdentity links another OAuth identity to an existing user so they are able
+// to login with either provider
+func (r *DatabaseRepository) LinkOAuthIdentity(ctx context.Context, userId string, oAuth *model.OAuth) (*model.User, error) {
+	var user *model.User
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var err error
+		user, err = r.GetUserWithTx(ctx,
+			`SELECT id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience, version, email_verified, prefilled_fields FROM users WHERE id = $1 AND deleted_at IS NULL LIMIT 1 FOR UPDATE`,
+			tx,
+			userId,
+		)
+		if err != nil {
+			return err
+		}
+		var linkedUserId string
+		err = tx.QueryRow(ctx, "SELECT user_id FROM oauth_identities WHERE provider = $1 AND uid = $2 AND deleted_at IS NULL", oAuth.Provider.String(), oAuth.UID).Scan(&linkedUserId)
+		if err == nil {
+			if linkedUserId == user.ID {
+				// linking an identity the user already has is a no-op
+				return nil
+			}
+			return repository.OAuthIdentityAlreadyLinked
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		// a user can only have a single identity per provider
+		var providerLinked bool
+		err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM oauth_identities WHERE user_id = $1 AND provider = $2)", userId, oAuth.Provider.String()).Scan(&providerLinked)
+		if err != nil {
+			return err
+		}
+		if providerLinked {
+			return repository.ProviderAlreadyLinked
+		}
+		userIdInt, err := strconv.Atoi(user.ID)
+		if err != nil {
+			return err
+		}
+		if err = r.InsertOAuthIdentity(ctx, tx, userIdInt, oAuth); err != nil {
+			return err
+		}
+		if err = r.bumpUserVersion(ctx, tx, user.ID); err != nil {
+			return err
+		}
+		err = r.InsertAuditLogEntry(ctx, tx, user.ID, model.AuditOperationLinkProvider, []*model.FieldChange{
+			{Field: "provider", After: utils.Ptr(oAuth.Provider.String())},
+		})
+		if err != nil {
+			return err
+		}
+		user, err = r.getUserByIdWithTx(ctx, tx, user.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// UnlinkOAuthIdentity removes the user's identity for the provider, the last identity
+// can never be removed since the user would no longer be able to login
+func (r *DatabaseRepository) UnlinkOAuthIdentity(ctx context.Context, userId string, provider model.OAuthProvider) (*model.User, error) {
+	var user *model.User
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var err error
+		// the user row is locked so two concurrent unlinks cannot both see a second identity
+		user, err = r.GetUserWithTx(ctx,
+			`SELECT id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience, version, email_verified, prefilled_fields FROM users WHERE id = $1 AND deleted_at IS NULL LIMIT 1 FOR UPDATE`,
+			tx,
+			userId,
+		)
+		if err != nil {
+			return err
+		}
+		var identities int
+		if err = tx.QueryRow(ctx, "SELECT COUNT(*) FROM oauth_identities WHERE user_id = $1", userId).Scan(&identities); err != nil {
+			return err
+		}
+		commandTag, err := tx.Exec(ctx, "DELETE FROM oauth_identities WHERE user_id = $1 AND provider = $2", userId, provider.String())
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return repository.OAuthIdentityNotFound
+		}
+		if identities <= 1 {
+			return repository.LastOAuthIdentity
+		}
+		if err = r.bumpUserVersion(ctx, tx, user.ID); err != nil {
+			return err
+		}
+		err = r.InsertAuditLogEntry(ctx, tx, user.ID, model.AuditOperationUnlinkProvider, []*model.FieldChange{
+			{Field: "provider", Before: utils.Ptr(provider.String())},
+		})
+		if err != nil {
+			return err
+		}
+		user, err = r.getUserByIdWithTx(ctx, tx, user.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,56 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/KnightHacks/knighthacks_users/oauth"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
+)
+
+// OAuthStateStore keeps oauth states in the oauth_states table so a user can be sent to
+// their provider by one instance of the service and come back to another
+type OAuthStateStore struct {
+	DatabasePool *pgxpool.Pool
+}
+
+func NewOAuthStateStore(databasePool *pgxpool.Pool) *OAuthStateStore {
+	return &OAuthStateStore{DatabasePool: databasePool}
+}
+
+func (s *OAuthStateStore) Save(ctx context.Context, state *oauth.State) error {
+	// expired states are removed here so the table doesn't grow forever
+	if _, err := s.DatabasePool.Exec(ctx, "DELETE FROM oauth_states WHERE expires_at <= $1", time.Now().UTC()); err != nil {
+		return err
+	}
+	_, err := s.DatabasePool.Exec(ctx, "INSERT INTO oauth_states (state_hash, provider, code_verifier, redirect_url, expires_at) VALUES ($1, $2, $3, $4, $5)",
+		HashToken(state.State),
+		state.Provider.String(),
+		state.CodeVerifier,
+		state.RedirectURL,
+		state.ExpiresAt.UTC(),
+	)
+	return err
+}
+
+func (s *OAuthStateStore) Take(ctx context.Context, state string) (*oauth.State, error) {
+	stored := oauth.State{State: state}
+	// deleting the row means only one request can take the state
+	err := s.DatabasePool.QueryRow(ctx, "DELETE FROM oauth_states WHERE state_hash = $1 RETURNING provider, code_verifier, redirect_url, expires_at", HashToken(state)).Scan(
+		&stored.Provider,
+		&stored.CodeVerifier,
+		&stored.RedirectURL,
+		&stored.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oauth.StateNotValid
+		}
+		return nil, err
+	}
+	if !time.Now().UTC().Before(stored.ExpiresAt) {
+		return nil, oauth.StateNotValid
+	}
+	return &stored, nil
+}
@@ -0,0 +1,109 @@
+package database
+
+import (
+	"context"
+	"github.com/KnightHacks/knighthacks_users/phonenumber"
+	"github.com/jackc/pgx/v5"
+	"strconv"
+)
+
+// PhoneNumberCollision is a set of users whose phone numbers are the same once normalized,
+// none of them are changed so an admin can decide which of them keeps the number
+type PhoneNumberCollision struct {
+	PhoneNumber string
+	UserIDs     []string
+}
+
+// PhoneNumberBackfill reports what BackfillPhoneNumbers did, or would do on a dry run
+type PhoneNumberBackfill struct {
+	Updated   int
+	Unchanged int
+	// Invalid are the stored numbers that could not be parsed, keyed by user id
+	Invalid    map[string]string
+	Collisions []*PhoneNumberCollision
+}
+
+// BackfillPhoneNumbers rewrites every stored phone number in E.164, parsing each one in the
+// region of the user's mailing address. Numbers that can't be parsed or that would collide
+// with another user's number are left as they are and reported instead.
+func (r *DatabaseRepository) BackfillPhoneNumbers(ctx context.Context, dryRun bool) (*PhoneNumberBackfill, error) {
+	report := &PhoneNumberBackfill{Invalid: map[string]string{}, Collisions: []*PhoneNumberCollision{}}
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{IsoLevel: pgx.Serializable}, func(tx pgx.Tx) error {
+		type storedNumber struct {
+			userId      string
+			phoneNumber string
+			country     string
+		}
+		rows, _ := tx.Query(ctx, `SELECT users.id, users.phone_number, coalesce(mailing_addresses.country, '') FROM users
+			LEFT JOIN mailing_addresses ON mailing_addresses.user_id = users.id
+			WHERE users.phone_number IS NOT NULL ORDER BY users.id`)
+		stored, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (storedNumber, error) {
+			var number storedNumber
+			var userId int
+			err := row.Scan(&userId, &number.phoneNumber, &number.country)
+			number.userId = strconv.Itoa(userId)
+			return number, err
+		})
+		if err != nil {
+			return err
+		}
+
+		// every valid number is grouped by its normalized form to find the collisions
+		normalized := make(map[string]string, len(stored))
+		usersByNumber := make(map[string][]storedNumber, len(stored))
+		var numbers []string
+		for _, number := range stored {
+			n, err := phonenumber.Normalize(number.phoneNumber, phonenumber.RegionForCountry(number.country))
+			if err != nil {
+				report.Invalid[number.userId] = number.phoneNumber
+				continue
+			}
+			normalized[number.userId] = n
+			if _, ok := usersByNumber[n]; !ok {
+				numbers = append(numbers, n)
+			}
+			usersByNumber[n] = append(usersByNumber[n], number)
+		}
+
+		changed := make([]storedNumber, 0)
+		for _, n := range numbers {
+			users := usersByNumber[n]
+			if len(users) > 1 {
+				collision := &PhoneNumberCollision{PhoneNumber: n, UserIDs: make([]string, 0, len(users))}
+				for _, user := range users {
+					collision.UserIDs = append(collision.UserIDs, user.userId)
+				}
+				report.Collisions = append(report.Collisions, collision)
+				continue
+			}
+			if users[0].phoneNumber == n {
+				report.Unchanged++
+				continue
+			}
+			changed = append(changed, users[0])
+		}
+		report.Updated = len(changed)
+		if dryRun || len(changed) == 0 {
+			return nil
+		}
+
+		// the numbers are cleared first, one user's new number can be another user's old number
+		changedIds := make([]string, 0, len(changed))
+		for _, number := range changed {
+			changedIds = append(changedIds, number.userId)
+		}
+		if _, err = tx.Exec(ctx, "UPDATE users SET phone_number = NULL WHERE id = ANY($1::int[])", changedIds); err != nil {
+			return err
+		}
+		for _, number := range changed {
+			if _, err = tx.Exec(ctx, "UPDATE users SET phone_number = $1 WHERE id = $2", normalized[number.userId], number.userId); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
@@ -0,0 +1,123 @@
+package database
+
+import (
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"sync"
+)
+
+// pronounKey is what pronouns are matched by, pronouns that only differ by case or whitespace
+// have the same key
+type pronounKey struct {
+	subjective string
+	objective  string
+	possessive string
+	askMe      bool
+}
+
+func keyOf(pronouns model.Pronouns) pronounKey {
+	normalized := NormalizePronouns(&model.PronounsInput{
+		Subjective: pronouns.Subjective,
+		Objective:  pronouns.Objective,
+		Possessive: pronouns.Possessive,
+		AskMe:      pronouns.AskMe,
+	})
+	key := pronounKey{subjective: normalized.Subjective, objective: normalized.Objective, askMe: normalized.AskMe}
+	if normalized.Possessive != nil {
+		key.possessive = *normalized.Possessive
+	}
+	return key
+}
+
+// PronounStore caches the pronouns table as a bidirectional map so users can be read without
+// joining on the pronouns table. It is safe to use from multiple goroutines.
+//
+// Only pronouns that have been committed may be set, a pronoun created by a transaction that
+// rolls back would otherwise be cached with an id that doesn't exist.
+type PronounStore struct {
+	mu         sync.RWMutex
+	byId       map[int]model.Pronouns
+	byPronouns map[pronounKey]int
+}
+
+func NewPronounStore() *PronounStore {
+	return &PronounStore{
+		byId:       map[int]model.Pronouns{},
+		byPronouns: map[pronounKey]int{},
+	}
+}
+
+// GetByID gets the pronouns by the sql row id
+func (s *PronounStore) GetByID(id int) (model.Pronouns, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	pronouns, exists := s.byId[id]
+	return pronouns, exists
+}
+
+// GetByPronouns gets the sql row id of the pronouns, ignoring case and extra whitespace
+func (s *PronounStore) GetByPronouns(pronouns model.Pronouns) (int, bool) {
+	key := keyOf(pronouns)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	id, exists := s.byPronouns[key]
+	return id, exists
+}
+
+// Set publishes committed pronouns to the store
+func (s *PronounStore) Set(id int, pronouns model.Pronouns) {
+	key := keyOf(pronouns)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// the id may have been cached with different pronouns before the table was changed
+	s.delete(id)
+	s.byId[id] = pronouns
+	if existing, exists := s.byPronouns[key]; !exists || id < existing {
+		s.byPronouns[key] = id
+	}
+}
+
+// Delete removes the pronouns from the store, e.g. once they have been merged into others
+func (s *PronounStore) Delete(id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.delete(id)
+}
+
+func (s *PronounStore) delete(id int) {
+	old, exists := s.byId[id]
+	if !exists {
+		return
+	}
+	delete(s.byId, id)
+	// other pronouns with the same key may be what the key points to
+	if key := keyOf(old); s.byPronouns[key] == id {
+		delete(s.byPronouns, key)
+	}
+}
+
+// Replace swaps everything in the store for the pronouns, readers see either the old or the
+// new pronouns and never a mix of both
+func (s *PronounStore) Replace(pronouns map[int]model.Pronouns) {
+	byId := make(map[int]model.Pronouns, len(pronouns))
+	byPronouns := make(map[pronounKey]int, len(pronouns))
+	for id, p := range pronouns {
+		byId[id] = p
+		// the oldest of pronouns that only differ by case or whitespace is the one matched, the
+		// same as GetOrCreatePronoun
+		key := keyOf(p)
+		if existing, exists := byPronouns[key]; !exists || id < existing {
+			byPronouns[key] = id
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.byId = byId
+	s.byPronouns = byPronouns
+}
+
+// Len is the number of pronouns in the store
+func (s *PronounStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.byId)
+}
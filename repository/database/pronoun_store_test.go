@@ -0,0 +1,124 @@
+package database
+
+import (
+	"fmt"
+	"github.com/KnightHacks/knighthacks_shared/utils"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestPronounStore(t *testing.T) {
+	store := NewPronounStore()
+	heHim := model.Pronouns{Subjective: "he", Objective: "him"}
+	store.Set(1, heHim)
+
+	if got, exists := store.GetByID(1); !exists || got != heHim {
+		t.Errorf("GetByID() = %v, %v, want %v, true", got, exists, heHim)
+	}
+	if got, exists := store.GetByPronouns(heHim); !exists || got != 1 {
+		t.Errorf("GetByPronouns() = %v, %v, want 1, true", got, exists)
+	}
+
+	// setting the id again must not leave the old pronouns pointing at it
+	theyThem := model.Pronouns{Subjective: "they", Objective: "them"}
+	store.Set(1, theyThem)
+	if _, exists := store.GetByPronouns(heHim); exists {
+		t.Errorf("GetByPronouns() found pronouns that were replaced")
+	}
+
+	store.Replace(map[int]model.Pronouns{2: heHim})
+	if _, exists := store.GetByID(1); exists {
+		t.Errorf("GetByID() found pronouns that were removed by Replace")
+	}
+	if got, exists := store.GetByPronouns(heHim); !exists || got != 2 {
+		t.Errorf("GetByPronouns() = %v, %v, want 2, true", got, exists)
+	}
+	if store.Len() != 1 {
+		t.Errorf("Len() = %v, want 1", store.Len())
+	}
+}
+
+func TestNormalizePronouns(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *model.PronounsInput
+		want  model.Pronouns
+	}{
+		{
+			name:  "case and whitespace",
+			input: &model.PronounsInput{Subjective: " He ", Objective: "HIM", Possessive: utils.Ptr("  His")},
+			want:  model.Pronouns{Subjective: "he", Objective: "him", Possessive: utils.Ptr("his")},
+		},
+		{
+			name:  "empty possessive",
+			input: &model.PronounsInput{Subjective: "they", Objective: "them", Possessive: utils.Ptr(" ")},
+			want:  model.Pronouns{Subjective: "they", Objective: "them"},
+		},
+		{
+			name:  "ask me",
+			input: &model.PronounsInput{Subjective: "Ask  me\tfirst", Objective: "please", Possessive: utils.Ptr("no"), AskMe: true},
+			want:  model.Pronouns{Subjective: "ask me first", AskMe: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizePronouns(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NormalizePronouns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPronounStore_Normalized(t *testing.T) {
+	store := NewPronounStore()
+	store.Replace(map[int]model.Pronouns{
+		1: {Subjective: "he", Objective: "him"},
+		// a duplicate stored before pronouns were normalized
+		2: {Subjective: "He ", Objective: "Him"},
+	})
+	if got, exists := store.GetByPronouns(model.Pronouns{Subjective: "HE", Objective: " him"}); !exists || got != 1 {
+		t.Errorf("GetByPronouns() = %v, %v, want 1, true", got, exists)
+	}
+	store.Delete(1)
+	if _, exists := store.GetByPronouns(model.Pronouns{Subjective: "he", Objective: "him"}); exists {
+		t.Errorf("GetByPronouns() found pronouns that were deleted")
+	}
+	if got, exists := store.GetByID(2); !exists || got.Subjective != "He " {
+		t.Errorf("GetByID() = %v, %v, want the duplicate to still be stored", got, exists)
+	}
+}
+
+// TestPronounStore_Concurrent is meant to be run with -race
+func TestPronounStore_Concurrent(t *testing.T) {
+	store := NewPronounStore()
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				pronouns := model.Pronouns{Subjective: fmt.Sprint("s", j%50), Objective: fmt.Sprint("o", j%50)}
+				switch (i + j) % 4 {
+				case 0:
+					store.Set(j%50, pronouns)
+				case 1:
+					if got, exists := store.GetByID(j % 50); exists && got != pronouns {
+						t.Errorf("GetByID(%d) = %v, want %v", j%50, got, pronouns)
+					}
+				case 2:
+					if got, exists := store.GetByPronouns(pronouns); exists && got != j%50 {
+						t.Errorf("GetByPronouns(%v) = %v, want %v", pronouns, got, j%50)
+					}
+				case 3:
+					if j%100 == 3 {
+						store.Replace(map[int]model.Pronouns{j % 50: pronouns})
+					}
+					store.Len()
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
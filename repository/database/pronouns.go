@@ -3,9 +3,14 @@ package database
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/KnightHacks/knighthacks_shared/database"
 	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
 	"github.com/jackc/pgx/v5"
+	"strconv"
+	"strings"
+	"time"
 )
 
 /*
@@ -19,94 +24,298 @@ $$ |      $$ |      \$$$$$$  |$$ |  $$ |\$$$$$$  |\$$$$$$  |$$ |  $$ |$$$$$$$  |
 \__|      \__|       \______/ \__|  \__| \______/  \______/ \__|  \__|\_______/
 */
 
-// GetByPronouns gets the sql row id for the pronouns associated with the input
-func (r *DatabaseRepository) GetByPronouns(pronouns model.Pronouns) (int, bool) {
-	id, exist := r.PronounReverseMap[pronouns]
-	return id, exist
+// normalizedPronoun is the sql equivalent of normalizePronoun
+const normalizedPronoun = `lower(btrim(regexp_replace(coalesce(%s, ''), '\s+', ' ', 'g')))`
+
+// pronounsMatch matches the rows with the normalized pronouns $1, $2, $3 and $4, rows stored
+// before pronouns were normalized aren't normalized themselves so both sides have to be
+var pronounsMatch = fmt.Sprintf("%s = $1 AND %s = $2 AND %s = $3 AND ask_me = $4",
+	fmt.Sprintf(normalizedPronoun, "subjective"),
+	fmt.Sprintf(normalizedPronoun, "objective"),
+	fmt.Sprintf(normalizedPronoun, "possessive"),
+)
+
+// NormalizePronouns returns the pronouns as they are stored, pronouns are compared ignoring
+// case and extra whitespace. The objective and possessive of "ask me" pronouns are dropped.
+func NormalizePronouns(input *model.PronounsInput) model.Pronouns {
+	pronouns := model.Pronouns{
+		Subjective: normalizePronoun(input.Subjective),
+		AskMe:      input.AskMe,
+	}
+	if input.AskMe {
+		return pronouns
+	}
+	pronouns.Objective = normalizePronoun(input.Objective)
+	if input.Possessive != nil {
+		if possessive := normalizePronoun(*input.Possessive); possessive != "" {
+			pronouns.Possessive = &possessive
+		}
+	}
+	return pronouns
 }
 
-// GetById gets the pronouns by the sql row id
-func (r *DatabaseRepository) GetById(id int) (model.Pronouns, bool) {
-	pronouns, exist := r.PronounMap[id]
-	return pronouns, exist
+func normalizePronoun(pronoun string) string {
+	return strings.ToLower(strings.Join(strings.Fields(pronoun), " "))
 }
 
-// Set inputs the pronouns into the bidirectional map
-func (r *DatabaseRepository) Set(id int, pronouns model.Pronouns) {
-	r.PronounMap[id] = pronouns
-	r.PronounReverseMap[pronouns] = id
+func scanPronouns(scannable Scannable, pronouns *model.Pronouns, extra ...interface{}) error {
+	return scannable.Scan(append([]interface{}{
+		&pronouns.Subjective,
+		&pronouns.Objective,
+		&pronouns.Possessive,
+		&pronouns.AskMe,
+	}, extra...)...)
 }
 
+// GetPronouns returns the pronouns by their sql row id
 func (r *DatabaseRepository) GetPronouns(ctx context.Context, queryable database.Queryable, pronounId int) (*model.Pronouns, error) {
-	pronouns, exists := r.GetById(pronounId)
-	// does the pronoun not exist in the local cache?
-	if !exists {
-		// retrieve the pronoun from the database
-		err := queryable.QueryRow(ctx, "SELECT subjective, objective FROM pronouns WHERE id = $1", pronounId).Scan(
-			&pronouns.Subjective,
-			&pronouns.Objective,
-		)
-		if err != nil {
-			return nil, err
-		}
-		// set the pronoun in the local cache
-		r.Set(pronounId, pronouns)
+	pronouns, exists := r.Pronouns.GetByID(pronounId)
+	if exists {
+		return &pronouns, nil
+	}
+	// the pool only sees committed rows, so what it finds can be cached
+	err := selectPronouns(ctx, r.DatabasePool, pronounId, &pronouns)
+	if err == nil {
+		r.Pronouns.Set(pronounId, pronouns)
+		return &pronouns, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	// the pronouns were created by the queryable's transaction, they can't be cached until
+	// it commits
+	if err = selectPronouns(ctx, queryable, pronounId, &pronouns); err != nil {
+		return nil, err
 	}
 	return &pronouns, nil
 }
 
-func (r *DatabaseRepository) GetOrCreatePronoun(ctx context.Context, queryable database.Queryable, pronouns model.Pronouns, input *model.NewUser) (*int, error) {
-	pronounId, exists := r.GetByPronouns(pronouns)
-	// if the pronoun does not exist in the local cache
-	if !exists {
-		// check if the pronoun exists in the database
-		err := queryable.QueryRow(ctx, "SELECT id FROM pronouns WHERE subjective=$1 AND objective=$2",
-			input.Pronouns.Subjective,
-			input.Pronouns.Objective,
-		).Scan(&pronounId)
-
-		pronounExist := true
-		if err != nil {
-			if errors.Is(err, pgx.ErrNoRows) {
-				// pronoun does not exist in the database
-				pronounExist = false
-			} else {
-				return nil, err
-			}
-		}
-
-		if !pronounExist {
-			// since the new pronoun does not exist in the database, we insert it
-			err = queryable.QueryRow(ctx, "INSERT INTO pronouns (subjective, objective) VALUES ($1, $2) RETURNING id",
-				input.Pronouns.Subjective,
-				input.Pronouns.Objective,
-			).Scan(&pronounId)
-		}
+func selectPronouns(ctx context.Context, queryable database.Queryable, pronounId int, pronouns *model.Pronouns) error {
+	return scanPronouns(queryable.QueryRow(ctx, "SELECT subjective, objective, possessive, ask_me FROM pronouns WHERE id = $1", pronounId), pronouns)
+}
 
-		if err != nil {
-			return nil, err
-		}
-		// set the pronoun cache
-		r.Set(pronounId, pronouns)
+// GetOrCreatePronoun returns the id of the pronouns, creating them if they don't exist. The
+// pronouns are matched ignoring case and extra whitespace. The id is always read from the
+// database, another instance may have merged away the pronouns the store still has. Created
+// pronouns aren't cached as the queryable's transaction may still roll back, they are cached
+// the first time they are read once committed.
+func (r *DatabaseRepository) GetOrCreatePronoun(ctx context.Context, queryable database.Queryable, pronouns model.Pronouns, input *model.NewUser) (*int, error) {
+	pronouns = NormalizePronouns(&model.PronounsInput{
+		Subjective: pronouns.Subjective,
+		Objective:  pronouns.Objective,
+		Possessive: pronouns.Possessive,
+		AskMe:      pronouns.AskMe,
+	})
+	possessive := ""
+	if pronouns.Possessive != nil {
+		possessive = *pronouns.Possessive
+	}
+	pronounId, err := selectPronounId(ctx, queryable, pronouns, possessive)
+	if err == nil {
+		return &pronounId, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	// since the new pronoun does not exist in the database, we insert it. A concurrent insert
+	// of the same pronouns is stopped by pronouns_normalized_uindex and then read back.
+	_, err = queryable.Exec(ctx, "INSERT INTO pronouns (subjective, objective, possessive, ask_me) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING",
+		pronouns.Subjective,
+		pronouns.Objective,
+		pronouns.Possessive,
+		pronouns.AskMe,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if pronounId, err = selectPronounId(ctx, queryable, pronouns, possessive); err != nil {
+		return nil, err
 	}
 	return &pronounId, nil
 }
 
+func selectPronounId(ctx context.Context, queryable database.Queryable, pronouns model.Pronouns, possessive string) (int, error) {
+	var pronounId int
+	err := queryable.QueryRow(ctx, "SELECT id FROM pronouns WHERE "+pronounsMatch,
+		pronouns.Subjective,
+		pronouns.Objective,
+		possessive,
+		pronouns.AskMe,
+	).Scan(&pronounId)
+	return pronounId, err
+}
+
+// LoadPronouns refreshes the pronoun store from the pronouns table, pronouns that no longer
+// exist are removed from the store
 func (r *DatabaseRepository) LoadPronouns(ctx context.Context) error {
-	rows, err := r.DatabasePool.Query(ctx, "SELECT id, subjective, objective FROM pronouns")
+	rows, err := r.DatabasePool.Query(ctx, "SELECT subjective, objective, possessive, ask_me, id FROM pronouns")
 	if err != nil {
 		return err
 	}
+	defer rows.Close()
 
+	loaded := make(map[int]model.Pronouns)
 	for rows.Next() {
 		var pronouns model.Pronouns
 		var id int
-		err = rows.Scan(&id, &pronouns.Subjective, &pronouns.Objective)
+		if err = scanPronouns(rows, &pronouns, &id); err != nil {
+			return err
+		}
+		loaded[id] = pronouns
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	r.Pronouns.Replace(loaded)
+	return nil
+}
+
+// GetPronounOptions returns the pronouns users are offered, "ask me" options are last
+func (r *DatabaseRepository) GetPronounOptions(ctx context.Context, includeRetired bool) ([]*model.PronounOption, error) {
+	rows, err := r.DatabasePool.Query(
+		ctx,
+		"SELECT subjective, objective, possessive, ask_me, id, retired_at IS NOT NULL FROM pronouns WHERE curated AND ($1 OR retired_at IS NULL) ORDER BY ask_me, id",
+		includeRetired,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	options := make([]*model.PronounOption, 0)
+	for rows.Next() {
+		option, err := scanPronounOption(rows)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, option)
+	}
+	return options, rows.Err()
+}
+
+func scanPronounOption(scannable Scannable) (*model.PronounOption, error) {
+	var pronouns model.Pronouns
+	var option model.PronounOption
+	var id int
+	if err := scanPronouns(scannable, &pronouns, &id, &option.Retired); err != nil {
+		return nil, err
+	}
+	option.ID = strconv.Itoa(id)
+	option.Pronouns = &pronouns
+	return &option, nil
+}
+
+// AddPronounOption offers the pronouns to users, matching pronouns are offered instead of
+// creating new ones and are stored normalized from then on
+func (r *DatabaseRepository) AddPronounOption(ctx context.Context, input *model.PronounsInput) (*model.PronounOption, error) {
+	pronouns := NormalizePronouns(input)
+	var option *model.PronounOption
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		pronounId, err := r.GetOrCreatePronoun(ctx, tx, pronouns, nil)
 		if err != nil {
 			return err
 		}
-		r.Set(id, pronouns)
+		option, err = scanPronounOption(tx.QueryRow(ctx, `UPDATE pronouns SET subjective = $2, objective = $3, possessive = $4, curated = true, retired_at = NULL
+			WHERE id = $1 RETURNING subjective, objective, possessive, ask_me, id, retired_at IS NOT NULL`,
+			*pronounId,
+			pronouns.Subjective,
+			pronouns.Objective,
+			pronouns.Possessive,
+		))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	id, _ := strconv.Atoi(option.ID)
+	r.Pronouns.Set(id, *option.Pronouns)
+	return option, nil
+}
+
+// RetirePronounOption stops offering the pronouns, users that picked them keep them
+func (r *DatabaseRepository) RetirePronounOption(ctx context.Context, id string) (*model.PronounOption, error) {
+	option, err := scanPronounOption(r.DatabasePool.QueryRow(ctx, `UPDATE pronouns SET retired_at = coalesce(retired_at, $2)
+		WHERE id = $1 AND curated RETURNING subjective, objective, possessive, ask_me, id, retired_at IS NOT NULL`,
+		id,
+		time.Now().UTC(),
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.PronounNotFound
+		}
+		return nil, err
 	}
+	return option, nil
+}
 
-	return nil
+// MergePronouns moves every user with the pronouns of mergeId onto keepId and deletes mergeId,
+// the kept pronouns are offered if either of them was. Each moved user gets a new version and
+// an audit log entry.
+func (r *DatabaseRepository) MergePronouns(ctx context.Context, keepId string, mergeId string) (*model.PronounOption, error) {
+	if keepId == mergeId {
+		return nil, repository.CannotMergeSamePronouns
+	}
+	var option *model.PronounOption
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var mergedCurated, mergedRetired bool
+		err := tx.QueryRow(ctx, "SELECT curated, retired_at IS NOT NULL FROM pronouns WHERE id = $1 FOR UPDATE", mergeId).Scan(&mergedCurated, &mergedRetired)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repository.PronounNotFound
+			}
+			return err
+		}
+		option, err = scanPronounOption(tx.QueryRow(ctx, `UPDATE pronouns SET curated = curated OR $2, retired_at = CASE WHEN $2 AND NOT $3 THEN NULL ELSE retired_at END
+			WHERE id = $1 RETURNING subjective, objective, possessive, ask_me, id, retired_at IS NOT NULL`,
+			keepId,
+			mergedCurated,
+			mergedRetired,
+		))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repository.PronounNotFound
+			}
+			return err
+		}
+
+		// soft deleted users are moved as well but can't be snapshot, they get a version when
+		// they are restored
+		rows, _ := tx.Query(ctx, "SELECT id::varchar FROM users WHERE pronoun_id = $1 AND deleted_at IS NULL ORDER BY id", mergeId)
+		userIds, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return err
+		}
+		befores := make([][]auditField, 0, len(userIds))
+		for _, userId := range userIds {
+			before, err := r.auditSnapshot(ctx, tx, userId)
+			if err != nil {
+				return err
+			}
+			befores = append(befores, before)
+		}
+		if _, err = tx.Exec(ctx, "UPDATE users SET pronoun_id = $1 WHERE pronoun_id = $2", keepId, mergeId); err != nil {
+			return err
+		}
+		for i, userId := range userIds {
+			if err = r.bumpUserVersion(ctx, tx, userId); err != nil {
+				return err
+			}
+			after, err := r.auditSnapshot(ctx, tx, userId)
+			if err != nil {
+				return err
+			}
+			if err = r.InsertAuditLogEntry(ctx, tx, userId, model.AuditOperationUpdateUser, diffAuditSnapshots(befores[i], after)); err != nil {
+				return err
+			}
+		}
+		// the users no longer reference the merged row so it can be deleted
+		_, err = tx.Exec(ctx, "DELETE FROM pronouns WHERE id = $1", mergeId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	merged, _ := strconv.Atoi(mergeId)
+	r.Pronouns.Delete(merged)
+	return option, nil
 }
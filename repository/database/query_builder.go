@@ -0,0 +1,67 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+)
+
+// queryBuilder collects the joins and conditions of a query along with their arguments, the
+// arguments are numbered in the order they are added. Conditions are always joined with AND.
+type queryBuilder struct {
+	joins      []string
+	conditions []string
+	args       []interface{}
+}
+
+// arg adds the argument and returns its placeholder
+func (b *queryBuilder) arg(arg interface{}) string {
+	b.args = append(b.args, arg)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+// where adds the condition, every %s in the condition is replaced by the placeholder of the
+// next argument
+func (b *queryBuilder) where(condition string, args ...interface{}) *queryBuilder {
+	placeholders := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		placeholders = append(placeholders, b.arg(arg))
+	}
+	b.conditions = append(b.conditions, fmt.Sprintf(condition, placeholders...))
+	return b
+}
+
+// join adds the join, the same join is only added once
+func (b *queryBuilder) join(join string) *queryBuilder {
+	for _, existing := range b.joins {
+		if existing == join {
+			return b
+		}
+	}
+	b.joins = append(b.joins, join)
+	return b
+}
+
+// clone returns a copy that can have more added to it without changing b
+func (b *queryBuilder) clone() *queryBuilder {
+	return &queryBuilder{
+		joins:      append([]string(nil), b.joins...),
+		conditions: append([]string(nil), b.conditions...),
+		args:       append([]interface{}(nil), b.args...),
+	}
+}
+
+// from is the FROM clause for the table with the joins and conditions
+func (b *queryBuilder) from(table string) string {
+	var sb strings.Builder
+	sb.WriteString("FROM ")
+	sb.WriteString(table)
+	for _, join := range b.joins {
+		sb.WriteString(" ")
+		sb.WriteString(join)
+	}
+	if len(b.conditions) > 0 {
+		sb.WriteString(" WHERE ")
+		sb.WriteString(strings.Join(b.conditions, " AND "))
+	}
+	return sb.String()
+}
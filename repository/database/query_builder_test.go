@@ -0,0 +1,96 @@
+package database
+
+import (
+	"github.com/KnightHacks/knighthacks_shared/models"
+	"github.com/KnightHacks/knighthacks_shared/utils"
+	"github.com/KnightHacks/knighthacks_users/cursor"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"reflect"
+	"testing"
+)
+
+func TestQueryBuilder(t *testing.T) {
+	b := &queryBuilder{}
+	b.where("users.deleted_at IS NULL")
+	b.where("users.age BETWEEN %s AND %s", 18, 21)
+	b.join(educationInfoJoin).join(educationInfoJoin)
+
+	clone := b.clone()
+	clone.where("users.role = %s", "ADMIN")
+
+	want := "FROM users " + educationInfoJoin + " WHERE users.deleted_at IS NULL AND users.age BETWEEN $1 AND $2"
+	if got := b.from("users"); got != want {
+		t.Errorf("from() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(b.args, []interface{}{18, 21}) {
+		t.Errorf("args = %v, want [18 21] as the clone must not change the original", b.args)
+	}
+	if got := clone.from("users"); got != want+" AND users.role = $3" {
+		t.Errorf("from() = %v, want the clone's condition added", got)
+	}
+}
+
+func TestFilterUsers(t *testing.T) {
+	b := &queryBuilder{}
+	filterUsers(b, &model.UserFilter{
+		Roles:            []models.Role{models.RoleAdmin},
+		SchoolName:       utils.Ptr(" 100%_Central "),
+		MinAge:           utils.Ptr(18),
+		AcceptedMlhTerms: utils.Ptr(false),
+	})
+	wantConditions := []string{
+		"users.role = ANY($1::varchar[])",
+		"users.age >= $2",
+		`EXISTS (SELECT 1 FROM education_info WHERE education_info.user_id = users.id AND education_info.name ILIKE $3 ESCAPE '\')`,
+		"NOT EXISTS (SELECT 1 FROM mlh_terms WHERE mlh_terms.user_id = users.id AND mlh_terms.code_of_conduct AND mlh_terms.share_info)",
+	}
+	if !reflect.DeepEqual(b.conditions, wantConditions) {
+		t.Errorf("conditions = %v, want %v", b.conditions, wantConditions)
+	}
+	wantArgs := []interface{}{[]string{"ADMIN"}, 18, `%100\%\_Central%`}
+	if !reflect.DeepEqual(b.args, wantArgs) {
+		t.Errorf("args = %v, want %v", b.args, wantArgs)
+	}
+
+	empty := &queryBuilder{}
+	filterUsers(empty, nil)
+	if len(empty.conditions) != 0 {
+		t.Errorf("conditions = %v, want none for no filter", empty.conditions)
+	}
+}
+
+func TestUserSort(t *testing.T) {
+	c := &cursor.Cursor{Key: "bob", ID: "3"}
+	b := &queryBuilder{}
+	sort := userSorts[model.UserSortLastName]
+	if got, want := sort.after(b, c), "(lower(users.last_name), users.id) > ($1::text, $2::int)"; got != want {
+		t.Errorf("after() = %v, want %v", got, want)
+	}
+	if got, want := sort.orderBy(true), "lower(users.last_name) DESC, users.id DESC"; got != want {
+		t.Errorf("orderBy() = %v, want %v", got, want)
+	}
+
+	newest := userSorts[model.UserSortNewest]
+	newestCursor := &cursor.Cursor{Key: "2023-01-02 03:04:05.123456", ID: "3"}
+	if got, want := newest.after(b, newestCursor), "(coalesce(users.created, '-infinity'), users.id) < ($3::timestamp, $4::int)"; got != want {
+		t.Errorf("after() = %v, want %v", got, want)
+	}
+	// users registered before it was recorded come last
+	if got, want := newest.orderBy(false), "coalesce(users.created, '-infinity') DESC, users.id DESC"; got != want {
+		t.Errorf("orderBy() = %v, want %v", got, want)
+	}
+
+	id := userSorts[model.UserSortID]
+	if got, want := id.after(b, c), "users.id > $5::int"; got != want {
+		t.Errorf("after() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(b.args, []interface{}{"bob", "3", "2023-01-02 03:04:05.123456", "3", "3"}) {
+		t.Errorf("args = %v", b.args)
+	}
+
+	for _, sortBy := range model.AllUserSort {
+		if _, ok := userSorts[sortBy]; !ok {
+			t.Errorf("userSorts is missing %v", sortBy)
+		}
+	}
+}
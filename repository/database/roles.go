@@ -0,0 +1,147 @@
+package database
+
+import (
+	"context"
+	"errors"
+	sharedModels "github.com/KnightHacks/knighthacks_shared/models"
+	"github.com/KnightHacks/knighthacks_shared/utils"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/jackc/pgx/v5"
+	"strconv"
+	"time"
+)
+
+// adminsLockKey is the advisory lock held while anything that can remove an admin is done, the
+// value is arbitrary but must not be used for any other advisory lock
+const adminsLockKey = 7_301_042
+
+// SetUserRole changes the role of the user and records who made the change.
+//
+// The admins lock is taken before the user is read so two admins demoting each other
+// at the same time cannot leave the service without an admin.
+func (r *DatabaseRepository) SetUserRole(ctx context.Context, id string, role sharedModels.Role, changedBy string) (*model.User, error) {
+	var user *model.User
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if err := lockAdmins(ctx, tx); err != nil {
+			return err
+		}
+		var previousRole sharedModels.Role
+		err := tx.QueryRow(ctx, "SELECT role FROM users WHERE id = $1 AND deleted_at IS NULL FOR UPDATE", id).Scan(&previousRole)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repository.UserNotFound
+			}
+			return err
+		}
+
+		if previousRole != role {
+			if previousRole == sharedModels.RoleAdmin {
+				admins, err := r.countAdmins(ctx, tx)
+				if err != nil {
+					return err
+				}
+				if admins <= 1 {
+					return repository.LastAdmin
+				}
+			}
+
+			if _, err = tx.Exec(ctx, "UPDATE users SET role = $1 WHERE id = $2", role, id); err != nil {
+				return err
+			}
+			if err = r.bumpUserVersion(ctx, tx, id); err != nil {
+				return err
+			}
+			_, err = tx.Exec(ctx, "INSERT INTO role_changes (user_id, previous_role, role, changed_by, changed_at) VALUES ($1, $2, $3, $4, $5)",
+				id,
+				previousRole,
+				role,
+				changedBy,
+				time.Now().UTC(),
+			)
+			if err != nil {
+				return err
+			}
+			err = r.InsertAuditLogEntry(ctx, tx, id, model.AuditOperationSetRole, []*model.FieldChange{
+				{Field: "role", Before: utils.Ptr(previousRole.String()), After: utils.Ptr(role.String())},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		user, err = r.getUserByIdWithTx(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// lockAdmins takes the admins lock until the transaction ends, it must be taken before reading
+// anything the admin count depends on. A single lock is used instead of locking every admin row
+// so transactions can never deadlock by locking the rows in different orders.
+func lockAdmins(ctx context.Context, tx pgx.Tx) error {
+	_, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1)", adminsLockKey)
+	return err
+}
+
+// checkNotLastAdmin takes the admins lock and returns LastAdmin if the user is the only admin
+// left, it must be called before the user's row is locked. Users that don't exist or are soft
+// deleted are never the last admin.
+func (r *DatabaseRepository) checkNotLastAdmin(ctx context.Context, tx pgx.Tx, id string) error {
+	if err := lockAdmins(ctx, tx); err != nil {
+		return err
+	}
+	var role sharedModels.Role
+	err := tx.QueryRow(ctx, "SELECT role FROM users WHERE id = $1 AND deleted_at IS NULL", id).Scan(&role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if role != sharedModels.RoleAdmin {
+		return nil
+	}
+	admins, err := r.countAdmins(ctx, tx)
+	if err != nil {
+		return err
+	}
+	if admins <= 1 {
+		return repository.LastAdmin
+	}
+	return nil
+}
+
+// countAdmins counts the admins, lockAdmins must have been called with tx
+func (r *DatabaseRepository) countAdmins(ctx context.Context, tx pgx.Tx) (int, error) {
+	var admins int
+	err := tx.QueryRow(ctx, "SELECT COUNT(*) FROM users WHERE role = $1 AND deleted_at IS NULL", sharedModels.RoleAdmin).Scan(&admins)
+	return admins, err
+}
+
+// GetRoleChanges returns every role change made to the user, oldest first
+func (r *DatabaseRepository) GetRoleChanges(ctx context.Context, userId string) ([]*model.RoleChange, error) {
+	rows, err := r.DatabasePool.Query(ctx, "SELECT previous_role, role, changed_by, changed_at FROM role_changes WHERE user_id = $1 ORDER BY changed_at, id", userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roleChanges := make([]*model.RoleChange, 0)
+	for rows.Next() {
+		var roleChange model.RoleChange
+		var changedBy *int
+		err = rows.Scan(&roleChange.PreviousRole, &roleChange.Role, &changedBy, &roleChange.ChangedAt)
+		if err != nil {
+			return nil, err
+		}
+		if changedBy != nil {
+			roleChange.ChangedBy = &model.User{ID: strconv.Itoa(*changedBy)}
+		}
+		roleChanges = append(roleChanges, &roleChange)
+	}
+	return roleChanges, rows.Err()
+}
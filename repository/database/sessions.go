@@ -0,0 +1,168 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/jackc/pgx/v5"
+	"strconv"
+	"time"
+)
+
+const (
+	// refreshTokenLength is the amount of random bytes used for a new refresh token
+	refreshTokenLength = 48
+	// RefreshTokenLifetime is how long a refresh token can be used after it is issued,
+	// a session that isn't refreshed within this time is effectively logged out
+	RefreshTokenLifetime = 30 * 24 * time.Hour
+)
+
+// CreateSession creates a new session for the user and returns its first refresh token
+func (r *DatabaseRepository) CreateSession(ctx context.Context, userId string, userAgent *string) (string, error) {
+	var refreshToken string
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		now := time.Now().UTC()
+		var sessionId int
+		err := tx.QueryRow(ctx, "INSERT INTO sessions (user_id, user_agent, created, last_used) VALUES ($1, $2, $3, $3) RETURNING id",
+			userId,
+			userAgent,
+			now,
+		).Scan(&sessionId)
+		if err != nil {
+			return err
+		}
+		refreshToken, err = r.InsertRefreshToken(ctx, tx, sessionId, now)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return refreshToken, nil
+}
+
+// InsertRefreshToken generates a new refresh token for the session and stores its hash
+func (r *DatabaseRepository) InsertRefreshToken(ctx context.Context, tx pgx.Tx, sessionId int, now time.Time) (string, error) {
+	refreshToken, err := GenerateToken(refreshTokenLength)
+	if err != nil {
+		return "", err
+	}
+	_, err = tx.Exec(ctx, "INSERT INTO refresh_tokens (token_hash, session_id, created, expires_at) VALUES ($1, $2, $3, $4)",
+		HashToken(refreshToken),
+		sessionId,
+		now,
+		now.Add(RefreshTokenLifetime),
+	)
+	if err != nil {
+		return "", err
+	}
+	return refreshToken, nil
+}
+
+// RotateRefreshToken exchanges the refresh token for a new one in the same session.
+//
+// A refresh token can only be exchanged once, if an already exchanged token is used again
+// it has most likely been stolen so the whole session is revoked and RefreshTokenReused
+// is returned.
+func (r *DatabaseRepository) RotateRefreshToken(ctx context.Context, refreshToken string) (string, string, error) {
+	var userId string
+	var newRefreshToken string
+	var reused bool
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		now := time.Now().UTC()
+		var sessionId, userIdInt int
+		var expiresAt time.Time
+		var rotatedAt, revokedAt *time.Time
+		err := tx.QueryRow(ctx, `SELECT sessions.id, sessions.user_id, refresh_tokens.expires_at, refresh_tokens.rotated_at, sessions.revoked_at
+		FROM refresh_tokens JOIN sessions ON sessions.id = refresh_tokens.session_id
+		WHERE refresh_tokens.token_hash = $1 FOR UPDATE`,
+			HashToken(refreshToken),
+		).Scan(&sessionId, &userIdInt, &expiresAt, &rotatedAt, &revokedAt)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repository.RefreshTokenNotValid
+			}
+			return err
+		}
+		if revokedAt != nil || now.After(expiresAt) {
+			return repository.RefreshTokenNotValid
+		}
+		if rotatedAt != nil {
+			// the revocation has to be committed, so the error is returned after the transaction
+			reused = true
+			_, err = tx.Exec(ctx, "UPDATE sessions SET revoked_at = $1 WHERE id = $2", now, sessionId)
+			return err
+		}
+
+		_, err = tx.Exec(ctx, "UPDATE refresh_tokens SET rotated_at = $1 WHERE token_hash = $2", now, HashToken(refreshToken))
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, "UPDATE sessions SET last_used = $1 WHERE id = $2", now, sessionId)
+		if err != nil {
+			return err
+		}
+		newRefreshToken, err = r.InsertRefreshToken(ctx, tx, sessionId, now)
+		if err != nil {
+			return err
+		}
+		userId = strconv.Itoa(userIdInt)
+		return nil
+	})
+	if err != nil {
+		return "", "", err
+	}
+	if reused {
+		return "", "", repository.RefreshTokenReused
+	}
+	return userId, newRefreshToken, nil
+}
+
+// RevokeSession logs out the session the refresh token belongs to
+func (r *DatabaseRepository) RevokeSession(ctx context.Context, refreshToken string) error {
+	commandTag, err := r.DatabasePool.Exec(ctx, `UPDATE sessions SET revoked_at = $1
+		WHERE id = (SELECT session_id FROM refresh_tokens WHERE token_hash = $2) AND revoked_at IS NULL`,
+		time.Now().UTC(),
+		HashToken(refreshToken),
+	)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return repository.RefreshTokenNotValid
+	}
+	return nil
+}
+
+// RevokeAllSessions logs out every session the user has
+func (r *DatabaseRepository) RevokeAllSessions(ctx context.Context, userId string) error {
+	_, err := r.DatabasePool.Exec(ctx, "UPDATE sessions SET revoked_at = $1 WHERE user_id = $2 AND revoked_at IS NULL", time.Now().UTC(), userId)
+	return err
+}
+
+// GetSessions returns every session of the user that can still be refreshed
+func (r *DatabaseRepository) GetSessions(ctx context.Context, userId string) ([]*model.Session, error) {
+	rows, err := r.DatabasePool.Query(ctx, `SELECT id, user_agent, created, last_used FROM sessions
+		WHERE user_id = $1 AND revoked_at IS NULL AND EXISTS(
+			SELECT 1 FROM refresh_tokens WHERE session_id = sessions.id AND rotated_at IS NULL AND expires_at > $2
+		) ORDER BY last_used DESC`,
+		userId,
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]*model.Session, 0)
+	for rows.Next() {
+		var session model.Session
+		var sessionId int
+		if err = rows.Scan(&sessionId, &session.UserAgent, &session.Created, &session.LastUsed); err != nil {
+			return nil, err
+		}
+		session.ID = strconv.Itoa(sessionId)
+		sessions = append(sessions, &session)
+	}
+	return sessions, rows.Err()
+}
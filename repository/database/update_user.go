@@ -7,6 +7,7 @@ import (
 	"github.com/KnightHacks/knighthacks_shared/database"
 	"github.com/KnightHacks/knighthacks_shared/utils"
 	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/phonenumber"
 	"github.com/KnightHacks/knighthacks_users/repository"
 	"github.com/jackc/pgx/v5"
 	"strconv"
@@ -35,15 +36,15 @@ type UpdateFunc[T any] func(ctx context.Context, id string, input T, tx pgx.Tx)
 // *any does not work bc when you use *any it passes your generic type into any and not as *any.
 // that would make it a double pointer and not a single pointer.
 func Validate[T *string |
-*float64 |
-*model.ShirtSize |
-*int |
-[]*string |
-*model.PronounsInput |
-*model.MailingAddressUpdate |
-*model.EducationInfoUpdate |
-*model.MLHTermsUpdate |
-[]model.Race](ctx context.Context, tx pgx.Tx, id string, input T, updateFunc UpdateFunc[T]) error {
+	*float64 |
+	*model.ShirtSize |
+	*int |
+	[]*string |
+	*model.PronounsInput |
+	*model.MailingAddressUpdate |
+	*model.EducationInfoUpdate |
+	*model.MLHTermsUpdate |
+	[]model.Race](ctx context.Context, tx pgx.Tx, id string, input T, updateFunc UpdateFunc[T]) error {
 	if input != nil {
 		err := updateFunc(ctx, id, input, tx)
 		if err != nil {
@@ -56,21 +57,57 @@ func Validate[T *string |
 // UpdateUser
 // update user add multiple parts go off of create user
 // we will check whether the values in input are nil or empty strings, if not, we execute the update statement
-func (r *DatabaseRepository) UpdateUser(ctx context.Context, id string, input *model.UpdatedUser) (*model.User, error) {
-	var user *model.User
-	var err error
+//
+// every update increments the user's version, if expectedVersion is given and the user is no longer
+// at that version a VersionConflictError is returned and nothing is changed
+//
+// the email is not changed directly, a change that expires at emailChangeExpiresAt is started in the
+// same transaction and its token is returned to be sent to the new email. The token is empty if the
+// email wasn't updated or the user already has it.
+func (r *DatabaseRepository) UpdateUser(ctx context.Context, id string, input *model.UpdatedUser, expectedVersion *int, emailChangeExpiresAt time.Time) (user *model.User, emailChangeToken string, err error) {
 	// checking to see if input is empty first
-	if input.FirstName == nil && input.LastName == nil && input.Email == nil && input.PhoneNumber == nil && input.Pronouns == nil && input.Age == nil {
-		return nil, errors.New("empty user field")
+	if input.IsEmpty() {
+		return nil, "", errors.New("empty user field")
 	}
 	err = pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// the snapshot is taken first so the user is locked before any field is changed
+		before, err := r.auditSnapshot(ctx, tx, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repository.UserNotFound
+			}
+			return err
+		}
+		var currentVersion int
+		if err = tx.QueryRow(ctx, "SELECT version FROM users WHERE id = $1", id).Scan(&currentVersion); err != nil {
+			return err
+		}
+		if expectedVersion != nil && *expectedVersion != currentVersion {
+			return &repository.VersionConflictError{ExpectedVersion: *expectedVersion, CurrentVersion: currentVersion}
+		}
+
+		if input.Email != nil {
+			emailChangeToken, err = r.createEmailChange(ctx, tx, id, *input.Email, emailChangeExpiresAt)
+			if err != nil && !errors.Is(err, repository.EmailUnchanged) {
+				return err
+			}
+			fields := *input
+			fields.Email = nil
+			if fields.IsEmpty() {
+				user, err = r.getUserByIdWithTx(ctx, tx, id)
+				return err
+			}
+			input = &fields
+		}
+
 		if err = Validate(ctx, tx, id, input.FirstName, r.UpdateFirstName); err != nil {
 			return err
 		}
 		if err = Validate(ctx, tx, id, input.LastName, r.UpdateLastName); err != nil {
 			return err
 		}
-		if err = Validate(ctx, tx, id, input.Email, r.UpdateEmail); err != nil {
+		// the mailing address is updated first as the phone number is parsed in the region of its country
+		if err = Validate(ctx, tx, id, input.MailingAddress, r.UpdateMailingAddress); err != nil {
 			return err
 		}
 		if err = Validate(ctx, tx, id, input.PhoneNumber, r.UpdatePhoneNumber); err != nil {
@@ -88,9 +125,6 @@ func (r *DatabaseRepository) UpdateUser(ctx context.Context, id string, input *m
 		if err = Validate(ctx, tx, id, input.Mlh, r.UpdateMLHTerms); err != nil {
 			return err
 		}
-		if err = Validate(ctx, tx, id, input.MailingAddress, r.UpdateMailingAddress); err != nil {
-			return err
-		}
 		if err = Validate(ctx, tx, id, input.ShirtSize, r.UpdateShirtSize); err != nil {
 			return err
 		}
@@ -103,21 +137,27 @@ func (r *DatabaseRepository) UpdateUser(ctx context.Context, id string, input *m
 		if err = Validate(ctx, tx, id, input.YearsOfExperience, r.UpdateYearsOfExperience); err != nil {
 			return err
 		}
+		// the version covers the mailing address, education info and mlh terms as well
+		if err = r.bumpUserVersion(ctx, tx, id); err != nil {
+			return err
+		}
+
+		user, err = r.getUserByIdWithTx(ctx, tx, id)
 
-		user, err = r.GetUserWithTx(ctx,
-			`SELECT id, first_name, last_name, email, phone_number, pronoun_id, age, role, gender, race, shirt_size, years_of_experience FROM users WHERE id = $1 LIMIT 1`,
-			tx,
-			id)
+		if err != nil {
+			return err
+		}
 
+		after, err := r.auditSnapshot(ctx, tx, id)
 		if err != nil {
 			return err
 		}
-		return nil
+		return r.InsertAuditLogEntry(ctx, tx, id, model.AuditOperationUpdateUser, diffAuditSnapshots(before, after))
 	})
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	return user, nil
+	return user, emailChangeToken, nil
 }
 
 // UpdateFirstName this will update first name
@@ -146,9 +186,9 @@ func (r *DatabaseRepository) UpdateLastName(ctx context.Context, id string, last
 	return nil
 }
 
-// UpdateEmail updates email
+// UpdateEmail updates email, the email is normalized first and is no longer verified
 func (r *DatabaseRepository) UpdateEmail(ctx context.Context, id string, email *string, tx pgx.Tx) error {
-	commandTag, err := tx.Exec(ctx, "UPDATE users SET email = $1 WHERE id = $2", email, id)
+	commandTag, err := tx.Exec(ctx, "UPDATE users SET email = $1, email_verified = false WHERE id = $2", NormalizeEmail(*email), id)
 	if err != nil {
 		return err
 	}
@@ -161,7 +201,17 @@ func (r *DatabaseRepository) UpdateEmail(ctx context.Context, id string, email *
 
 // UpdatePhoneNumber updates user phone number
 func (r *DatabaseRepository) UpdatePhoneNumber(ctx context.Context, id string, number *string, tx pgx.Tx) error {
-	commandTag, err := tx.Exec(ctx, "UPDATE users SET phone_number = $1 WHERE id = $2", number, id)
+	// the number is parsed in the region of the user's mailing address
+	var country string
+	err := tx.QueryRow(ctx, "SELECT country FROM mailing_addresses WHERE user_id = $1", id).Scan(&country)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	phoneNumber, err := phonenumber.Normalize(*number, phonenumber.RegionForCountry(country))
+	if err != nil {
+		return err
+	}
+	commandTag, err := tx.Exec(ctx, "UPDATE users SET phone_number = $1 WHERE id = $2", phoneNumber, id)
 	if err != nil {
 		return err
 	}
@@ -376,42 +426,9 @@ func (r *DatabaseRepository) UpdateMailingAddress(ctx context.Context, id string
 // UpdatePronouns updates user Pronouns
 func (r *DatabaseRepository) UpdatePronouns(ctx context.Context, id string, pronoun *model.PronounsInput, tx pgx.Tx) error {
 	// first find pronouns, if it doesn't exist this will add to database and then update user pronoun in database
-	// copied from createUser
-	var pronouns = model.Pronouns{
-		Subjective: pronoun.Subjective,
-		Objective:  pronoun.Objective,
-	}
-	pronounId, exists := r.GetByPronouns(pronouns)
-
-	if !exists {
-		// check if the pronoun exists in the database
-		err := tx.QueryRow(ctx, "SELECT id FROM pronouns WHERE subjective=$1 AND objective=$2",
-			pronoun.Subjective,
-			pronoun.Objective,
-		).Scan(&pronounId)
-
-		pronounExist := true
-		if err != nil {
-			if errors.Is(err, pgx.ErrNoRows) {
-				// pronoun does not exist in the database
-				pronounExist = false
-			} else {
-				return err
-			}
-		}
-		if !pronounExist {
-			// since the new pronoun does not exist in the database, we insert it
-			err = tx.QueryRow(ctx, "INSERT INTO pronouns (subjective, objective) VALUES ($1, $2) RETURNING id",
-				pronoun.Subjective,
-				pronoun.Objective,
-			).Scan(&pronounId)
-		}
-
-		if err != nil {
-			return err
-		}
-		// set the pronoun cache
-		r.Set(pronounId, pronouns)
+	pronounId, err := r.GetOrCreatePronoun(ctx, tx, NormalizePronouns(pronoun), nil)
+	if err != nil {
+		return err
 	}
 
 	commandTag, err := tx.Exec(ctx, "UPDATE users SET pronoun_id = $1 WHERE id = $2", pronounId, id)
@@ -429,11 +446,11 @@ type Scannable interface {
 	Scan(dest ...interface{}) error
 }
 
-func ScanUser[T Scannable](user *model.User, scannable T) (*int, error) {
+func ScanUser[T Scannable](user *model.User, scannable T, extra ...interface{}) (*int, error) {
 	var pronounVal uint32
 	pronounId := &pronounVal
 	var userIdInt int
-	err := scannable.Scan(
+	dest := append([]interface{}{
 		&userIdInt,
 		&user.FirstName,
 		&user.LastName,
@@ -446,7 +463,11 @@ func ScanUser[T Scannable](user *model.User, scannable T) (*int, error) {
 		&user.Race,
 		&user.ShirtSize,
 		&user.YearsOfExperience,
-	)
+		&user.Version,
+		&user.EmailVerified,
+		&user.PrefilledFields,
+	}, extra...)
+	err := scannable.Scan(dest...)
 	if err != nil {
 		return nil, err
 	}
@@ -456,20 +477,3 @@ func ScanUser[T Scannable](user *model.User, scannable T) (*int, error) {
 	}
 	return utils.Ptr(int(*pronounId)), nil
 }
-
-func (r *DatabaseRepository) DeleteAPIKey(ctx context.Context, id string) error {
-	_, err := r.DatabasePool.Exec(ctx, "DELETE FROM api_keys WHERE user_id = $1", id)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (r *DatabaseRepository) AddAPIKey(ctx context.Context, id string, key string) (*model.APIKey, error) {
-	now := time.Now()
-	_, err := r.DatabasePool.Exec(ctx, "INSERT INTO api_keys (user_id, key, created) VALUES ($1, $2, $3)", id, key, now)
-	if err != nil {
-		return nil, err
-	}
-	return &model.APIKey{Key: key, Created: time.Now()}, nil
-}
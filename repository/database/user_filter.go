@@ -0,0 +1,85 @@
+package database
+
+import (
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"strings"
+	"time"
+)
+
+// filterUsers adds the conditions of the filter to the query, the tables that belong to a user
+// are checked with EXISTS so a user is never returned more than once
+func filterUsers(b *queryBuilder, filter *model.UserFilter) {
+	if filter == nil {
+		return
+	}
+	if len(filter.Roles) > 0 {
+		roles := make([]string, 0, len(filter.Roles))
+		for _, role := range filter.Roles {
+			roles = append(roles, role.String())
+		}
+		b.where("users.role = ANY(%s::varchar[])", roles)
+	}
+	if len(filter.ShirtSizes) > 0 {
+		shirtSizes := make([]string, 0, len(filter.ShirtSizes))
+		for _, shirtSize := range filter.ShirtSizes {
+			shirtSizes = append(shirtSizes, shirtSize.String())
+		}
+		b.where("users.shirt_size = ANY(%s::varchar[])", shirtSizes)
+	}
+	if filter.MinAge != nil {
+		b.where("users.age >= %s", *filter.MinAge)
+	}
+	if filter.MaxAge != nil {
+		b.where("users.age <= %s", *filter.MaxAge)
+	}
+	if filter.RegisteredAfter != nil {
+		b.where("users.created >= %s", filter.RegisteredAfter.UTC())
+	}
+	if filter.RegisteredBefore != nil {
+		b.where("users.created < %s", filter.RegisteredBefore.UTC())
+	}
+
+	if len(filter.LevelsOfStudy) > 0 {
+		levels := make([]string, 0, len(filter.LevelsOfStudy))
+		for _, level := range filter.LevelsOfStudy {
+			levels = append(levels, level.String())
+		}
+		b.where("EXISTS (SELECT 1 FROM education_info WHERE education_info.user_id = users.id AND education_info.level = ANY(%s::varchar[]))", levels)
+	}
+	if filter.SchoolName != nil {
+		b.where(`EXISTS (SELECT 1 FROM education_info WHERE education_info.user_id = users.id AND education_info.name ILIKE %s ESCAPE '\')`, "%"+escapeLike(strings.TrimSpace(*filter.SchoolName))+"%")
+	}
+	if filter.GraduationYear != nil {
+		// a range instead of the year of the date so the index on graduation_date can be used
+		from := time.Date(*filter.GraduationYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+		b.where("EXISTS (SELECT 1 FROM education_info WHERE education_info.user_id = users.id AND education_info.graduation_date >= %s AND education_info.graduation_date < %s)", from, from.AddDate(1, 0, 0))
+	}
+
+	if filter.Country != nil {
+		b.where("EXISTS (SELECT 1 FROM mailing_addresses WHERE mailing_addresses.user_id = users.id AND lower(mailing_addresses.country) = lower(%s))", strings.TrimSpace(*filter.Country))
+	}
+	if filter.State != nil {
+		b.where("EXISTS (SELECT 1 FROM mailing_addresses WHERE mailing_addresses.user_id = users.id AND lower(mailing_addresses.state) = lower(%s))", strings.TrimSpace(*filter.State))
+	}
+
+	if filter.AcceptedMlhTerms != nil {
+		accepted := "EXISTS (SELECT 1 FROM mlh_terms WHERE mlh_terms.user_id = users.id AND mlh_terms.code_of_conduct AND mlh_terms.share_info)"
+		if !*filter.AcceptedMlhTerms {
+			accepted = "NOT " + accepted
+		}
+		b.where(accepted)
+	}
+	if filter.HasAPIKey != nil {
+		// expires_at is stored in UTC like every other timestamp
+		hasAPIKey := "EXISTS (SELECT 1 FROM api_keys WHERE api_keys.user_id = users.id AND (api_keys.expires_at IS NULL OR api_keys.expires_at > timezone('utc', now())))"
+		if !*filter.HasAPIKey {
+			hasAPIKey = "NOT " + hasAPIKey
+		}
+		b.where(hasAPIKey)
+	}
+}
+
+// escapeLike escapes the characters that are special in a LIKE pattern
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,218 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/KnightHacks/knighthacks_users/cursor"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+// userSort is an order users can be listed in, users with the same key are ordered by id so
+// the order is always stable
+type userSort struct {
+	// key is the sql expression users are sorted by, empty to sort by id alone. It must never
+	// be null.
+	key string
+	// keyType is what the key of a cursor is cast to
+	keyType string
+	// join is needed by the key, if any
+	join string
+	desc bool
+}
+
+const educationInfoJoin = "LEFT JOIN education_info ON education_info.user_id = users.id"
+
+// userSorts are the sorts of model.UserSort, keys without a value are coalesced to the lowest
+// value so they come first, or last in the descending sorts
+var userSorts = map[model.UserSort]userSort{
+	model.UserSortID:             {},
+	model.UserSortNewest:         {key: "coalesce(users.created, '-infinity')", keyType: "timestamp", desc: true},
+	model.UserSortFirstName:      {key: "lower(users.first_name)", keyType: "text"},
+	model.UserSortLastName:       {key: "lower(users.last_name)", keyType: "text"},
+	model.UserSortAge:            {key: "coalesce(users.age, -1)", keyType: "int"},
+	model.UserSortSchoolName:     {key: "lower(coalesce(education_info.name, ''))", keyType: "text", join: educationInfoJoin},
+	model.UserSortGraduationDate: {key: "coalesce(education_info.graduation_date, '-infinity')", keyType: "timestamp", join: educationInfoJoin},
+}
+
+// position is the sql comparing a user's position in the sort with the cursor's
+func (s userSort) position(b *queryBuilder, c *cursor.Cursor) (column string, value string) {
+	if s.key == "" {
+		return "users.id", b.arg(c.ID) + "::int"
+	}
+	return fmt.Sprintf("(%s, users.id)", s.key), fmt.Sprintf("(%s::%s, %s::int)", b.arg(c.Key), s.keyType, b.arg(c.ID))
+}
+
+// after is the condition of the users that come after the cursor in the sort
+func (s userSort) after(b *queryBuilder, c *cursor.Cursor) string {
+	column, value := s.position(b, c)
+	if s.desc {
+		return column + " < " + value
+	}
+	return column + " > " + value
+}
+
+// before is the condition of the users that come before the cursor in the sort
+func (s userSort) before(b *queryBuilder, c *cursor.Cursor) string {
+	column, value := s.position(b, c)
+	if s.desc {
+		return column + " > " + value
+	}
+	return column + " < " + value
+}
+
+// orderBy is the order of the sort, or the opposite order when reversed
+func (s userSort) orderBy(reversed bool) string {
+	direction := "ASC"
+	if s.desc != reversed {
+		direction = "DESC"
+	}
+	if s.key == "" {
+		return "users.id " + direction
+	}
+	return fmt.Sprintf("%s %s, users.id %s", s.key, direction, direction)
+}
+
+// GetUsers returns a page of the users that haven't been deleted and match the filter
+func (r *DatabaseRepository) GetUsers(ctx context.Context, filter *model.UserFilter, sortBy model.UserSort, page repository.Page) (*model.UsersConnection, error) {
+	sort, ok := userSorts[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown user sort %s", sortBy)
+	}
+	for _, c := range []*cursor.Cursor{page.After, page.Before} {
+		if c != nil && c.Sort != sortBy.String() {
+			return nil, cursor.NotValid
+		}
+	}
+
+	// the filter is shared by the page, the total count and checking if there are more users
+	filtered := &queryBuilder{}
+	filtered.where("users.deleted_at IS NULL")
+	filterUsers(filtered, filter)
+	if sort.join != "" {
+		filtered.join(sort.join)
+	}
+
+	query := filtered.clone()
+	if page.After != nil {
+		query.where(sort.after(query, page.After))
+	}
+	if page.Before != nil {
+		query.where(sort.before(query, page.Before))
+	}
+
+	// last takes the users from the end, so they are fetched in reverse and flipped back
+	limit, reversed := 0, page.Last != nil
+	if reversed {
+		limit = *page.Last
+	} else if page.First != nil {
+		limit = *page.First
+	}
+
+	key := "''"
+	if sort.key != "" {
+		key = sort.key + "::text"
+	}
+	// one more user than asked for is fetched to know if there are more
+	sql := fmt.Sprintf(
+		"SELECT users.id, users.first_name, users.last_name, users.email, users.phone_number, users.pronoun_id, users.age, users.role, users.gender, users.race, users.shirt_size, users.years_of_experience, users.version, users.email_verified, users.prefilled_fields, %s %s ORDER BY %s LIMIT %s",
+		key,
+		query.from("users"),
+		sort.orderBy(reversed),
+		query.arg(limit+1),
+	)
+
+	connection := &model.UsersConnection{
+		PageInfo: &model.UsersPageInfo{},
+		Users:    make([]*model.User, 0, limit),
+		Edges:    make([]*model.UserEdge, 0, limit),
+	}
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, sql, query.args...)
+		if err != nil {
+			return err
+		}
+		pronounIds := make(map[*model.User]int)
+		for rows.Next() {
+			var user model.User
+			var c cursor.Cursor
+			pronounId, err := ScanUser(&user, rows, &c.Key)
+			if err != nil {
+				rows.Close()
+				return err
+			}
+			if pronounId != nil {
+				pronounIds[&user] = *pronounId
+			}
+			c.Sort, c.ID = sortBy.String(), user.ID
+			connection.Users = append(connection.Users, &user)
+			connection.Edges = append(connection.Edges, &model.UserEdge{Cursor: c.Encode(), Node: &user})
+		}
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		// the rows have to be read before the pronouns can be queried on the same transaction
+		for user, pronounId := range pronounIds {
+			pronouns, err := r.GetPronouns(ctx, tx, pronounId)
+			if err != nil {
+				return err
+			}
+			user.Pronouns = pronouns
+		}
+
+		hasMore := len(connection.Users) > limit
+		if hasMore {
+			connection.Users = connection.Users[:limit]
+			connection.Edges = connection.Edges[:limit]
+		}
+		if reversed {
+			reverse(connection.Users)
+			reverse(connection.Edges)
+		}
+
+		// the other side of the page has more users if any are on the other side of its cursor
+		if reversed {
+			connection.PageInfo.HasPreviousPage = hasMore
+			if page.Before != nil {
+				others := filtered.clone()
+				others.where("NOT (" + sort.before(others, page.Before) + ")")
+				if connection.PageInfo.HasNextPage, err = usersExist(ctx, tx, others); err != nil {
+					return err
+				}
+			}
+		} else {
+			connection.PageInfo.HasNextPage = hasMore
+			if page.After != nil {
+				others := filtered.clone()
+				others.where("NOT (" + sort.after(others, page.After) + ")")
+				if connection.PageInfo.HasPreviousPage, err = usersExist(ctx, tx, others); err != nil {
+					return err
+				}
+			}
+		}
+
+		return tx.QueryRow(ctx, "SELECT COUNT(*) "+filtered.from("users"), filtered.args...).Scan(&connection.TotalCount)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(connection.Edges) > 0 {
+		connection.PageInfo.StartCursor = connection.Edges[0].Cursor
+		connection.PageInfo.EndCursor = connection.Edges[len(connection.Edges)-1].Cursor
+	}
+	return connection, nil
+}
+
+func usersExist(ctx context.Context, tx pgx.Tx, b *queryBuilder) (bool, error) {
+	var exists bool
+	err := tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 "+b.from("users")+")", b.args...).Scan(&exists)
+	return exists, err
+}
+
+func reverse[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
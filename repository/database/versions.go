@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+// bumpUserVersion increments the user's version and stores a snapshot of the user under it,
+// every change to a user must call it in the change's transaction once the user is changed
+func (r *DatabaseRepository) bumpUserVersion(ctx context.Context, tx pgx.Tx, userId string) error {
+	if _, err := tx.Exec(ctx, "UPDATE users SET version = version + 1 WHERE id = $1", userId); err != nil {
+		return err
+	}
+	return r.InsertUserVersion(ctx, tx, userId)
+}
+
+// InsertUserVersion stores a snapshot of the user and their mailing address, education info
+// and MLH terms as they are inside the transaction under the user's current version. It must
+// be called after every change to the user so userAsOf can return the user as they were at
+// any point in time.
+func (r *DatabaseRepository) InsertUserVersion(ctx context.Context, tx pgx.Tx, userId string) error {
+	user, err := r.getUserByIdWithTx(ctx, tx, userId)
+	if err != nil {
+		return err
+	}
+	snapshot := &model.UserSnapshot{
+		ID:                user.ID,
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		Email:             user.Email,
+		PhoneNumber:       user.PhoneNumber,
+		Pronouns:          user.Pronouns,
+		Age:               user.Age,
+		Role:              user.Role,
+		Gender:            user.Gender,
+		Race:              user.Race,
+		ShirtSize:         user.ShirtSize,
+		YearsOfExperience: user.YearsOfExperience,
+	}
+	if snapshot.MailingAddress, err = getMailingAddressWithTx(ctx, tx, userId); err != nil {
+		return err
+	}
+	if snapshot.Mlh, err = getMLHTermsWithTx(ctx, tx, userId); err != nil {
+		return err
+	}
+	if snapshot.EducationInfo, err = getEducationInfoWithTx(ctx, tx, userId); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO user_versions (user_id, version, snapshot, created) VALUES ($1, $2, $3, $4)",
+		userId,
+		user.Version,
+		snapshot,
+		time.Now().UTC(),
+	)
+	return err
+}
+
+// GetUserVersions returns every version of the user, oldest first
+func (r *DatabaseRepository) GetUserVersions(ctx context.Context, userId string) ([]*model.UserVersion, error) {
+	var versions []*model.UserVersion
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{AccessMode: pgx.ReadOnly}, func(tx pgx.Tx) error {
+		var err error
+		versions, err = getUserVersionsWithTx(ctx, tx, userId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// GetUserVersionAsOf returns the version of the user that was current at the time, nil if
+// the user had no version yet
+func (r *DatabaseRepository) GetUserVersionAsOf(ctx context.Context, userId string, asOf time.Time) (*model.UserVersion, error) {
+	var version model.UserVersion
+	err := r.DatabasePool.QueryRow(ctx, "SELECT version, created, snapshot FROM user_versions WHERE user_id = $1 AND created <= $2 ORDER BY version DESC LIMIT 1",
+		userId,
+		asOf.UTC(),
+	).Scan(&version.Version, &version.Created, &version.User)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &version, nil
+}
+
+func getUserVersionsWithTx(ctx context.Context, tx pgx.Tx, userId string) ([]*model.UserVersion, error) {
+	rows, _ := tx.Query(ctx, "SELECT version, created, snapshot FROM user_versions WHERE user_id = $1 ORDER BY version", userId)
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.UserVersion, error) {
+		var version model.UserVersion
+		return &version, row.Scan(&version.Version, &version.Created, &version.User)
+	})
+}
+
+func getMailingAddressWithTx(ctx context.Context, tx pgx.Tx, userId string) (*model.MailingAddress, error) {
+	var mailingAddress model.MailingAddress
+	err := tx.QueryRow(ctx, "SELECT country, state, city, postal_code, address_lines FROM mailing_addresses WHERE user_id = $1", userId).Scan(
+		&mailingAddress.Country,
+		&mailingAddress.State,
+		&mailingAddress.City,
+		&mailingAddress.PostalCode,
+		&mailingAddress.AddressLines,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &mailingAddress, nil
+}
+
+func getMLHTermsWithTx(ctx context.Context, tx pgx.Tx, userId string) (*model.MLHTerms, error) {
+	var mlhTerms model.MLHTerms
+	err := tx.QueryRow(ctx, "SELECT send_messages, share_info, code_of_conduct FROM mlh_terms WHERE user_id = $1", userId).Scan(
+		&mlhTerms.SendMessages,
+		&mlhTerms.ShareInfo,
+		&mlhTerms.CodeOfConduct,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &mlhTerms, nil
+}
+
+func getEducationInfoWithTx(ctx context.Context, tx pgx.Tx, userId string) (*model.EducationInfo, error) {
+	var educationInfo model.EducationInfo
+	err := tx.QueryRow(ctx, "SELECT name, major, graduation_date, level FROM education_info WHERE user_id = $1", userId).Scan(
+		&educationInfo.Name,
+		&educationInfo.Major,
+		&educationInfo.GraduationDate,
+		&educationInfo.Level,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &educationInfo, nil
+}
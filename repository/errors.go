@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+)
+
+// VersionConflictError is returned when the user was changed by someone else after the
+// version the caller last read, the caller should reload the user and try again
+type VersionConflictError struct {
+	ExpectedVersion int
+	CurrentVersion  int
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("user has been changed since version %d, the current version is %d", e.ExpectedVersion, e.CurrentVersion)
+}
+
+// MergeConflict is a row that both users have, such as an application to the same hackathon
+type MergeConflict struct {
+	Table string `json:"table"`
+	// Key is the id of the hackathon or event the rows are for
+	Key string `json:"key"`
+}
+
+// MergeConflictError is returned when both users being merged have rows that only one of them
+// could keep. The merge is refused instead of dropping the merged user's rows, an admin has to
+// decide which to keep first.
+type MergeConflictError struct {
+	Conflicts []MergeConflict
+}
+
+func (e *MergeConflictError) Error() string {
+	conflicts := make([]string, 0, len(e.Conflicts))
+	for _, conflict := range e.Conflicts {
+		conflicts = append(conflicts, fmt.Sprintf("%s %s", conflict.Table, conflict.Key))
+	}
+	return fmt.Sprintf("both users have rows that only one of them can keep: %s", strings.Join(conflicts, ", "))
+}
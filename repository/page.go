@@ -0,0 +1,12 @@
+package repository
+
+import "github.com/KnightHacks/knighthacks_users/cursor"
+
+// Page selects a page of a connection. Only the entries between After and Before are
+// paginated, First takes entries from the start of them and Last from the end.
+type Page struct {
+	First  *int
+	After  *cursor.Cursor
+	Last   *int
+	Before *cursor.Cursor
+}
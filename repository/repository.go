@@ -2,28 +2,74 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/KnightHacks/knighthacks_shared/models"
+	"github.com/KnightHacks/knighthacks_users/cursor"
 	"github.com/KnightHacks/knighthacks_users/graph/model"
 )
 
 type Repository interface {
 	GetUserByID(ctx context.Context, id string) (*model.User, error)
-	GetUserByOAuthUID(ctx context.Context, oAuthUID string, provider models.Provider) (*model.User, error)
+	GetUserByOAuthUID(ctx context.Context, oAuthUID string, provider model.OAuthProvider) (*model.User, error)
 	GetUserMailingAddress(ctx context.Context, userId string) (*model.MailingAddress, error)
 	GetUserMLHTerms(ctx context.Context, userId string) (*model.MLHTerms, error)
 
-	UpdateUser(ctx context.Context, id string, input *model.UpdatedUser) (*model.User, error)
+	GetUsersByIDs(ctx context.Context, ids []string) (map[string]*model.User, error)
+	GetUsersByApplicationIDs(ctx context.Context, applicationIds []string) (map[string]*model.User, error)
+	GetUsersByOAuthIdentities(ctx context.Context, identities []model.OAuth) (map[model.OAuth]*model.User, error)
+	GetOAuths(ctx context.Context, userIds []string) (map[string]*model.OAuth, error)
+	GetMailingAddresses(ctx context.Context, userIds []string) (map[string]*model.MailingAddress, error)
+	GetMLHTerms(ctx context.Context, userIds []string) (map[string]*model.MLHTerms, error)
+	GetEducationInfos(ctx context.Context, userIds []string) (map[string]*model.EducationInfo, error)
+	GetAPIKeysByUserIDs(ctx context.Context, userIds []string) (map[string][]*model.APIKey, error)
+
+	UpdateUser(ctx context.Context, id string, input *model.UpdatedUser, expectedVersion *int, emailChangeExpiresAt time.Time) (user *model.User, emailChangeToken string, err error)
+
+	CreateEmailChange(ctx context.Context, userId string, newEmail string, expiresAt time.Time) (string, error)
+	ConfirmEmailChange(ctx context.Context, token string) (*model.User, error)
+	GetPendingEmail(ctx context.Context, userId string) (*string, error)
+	MarkEmailVerified(ctx context.Context, userId string, email string) (bool, error)
+	CreateEmailVerification(ctx context.Context, userId string, expiresAt time.Time) (token string, email string, err error)
+	VerifyEmail(ctx context.Context, token string) (*model.User, error)
+	IsEmailVerified(ctx context.Context, userId string) (bool, error)
 
 	GetOAuth(ctx context.Context, userId string) (*model.OAuth, error)
+	GetOAuthIdentities(ctx context.Context, userId string) ([]*model.OAuth, error)
+	LinkOAuthIdentity(ctx context.Context, userId string, oAuth *model.OAuth) (*model.User, error)
+	UnlinkOAuthIdentity(ctx context.Context, userId string, provider model.OAuthProvider) (*model.User, error)
 
-	GetUsers(ctx context.Context, first int, after string) ([]*model.User, int, error)
+	GetUsers(ctx context.Context, filter *model.UserFilter, sort model.UserSort, page Page) (*model.UsersConnection, error)
 	SearchUser(ctx context.Context, name string) ([]*model.User, error)
-	DeleteUser(ctx context.Context, id string) (bool, error)
-	CreateUser(ctx context.Context, oAuth *model.OAuth, input *model.NewUser) (*model.User, error)
-	GetAPIKey(ctx context.Context, userId string) (apiKey *model.APIKey, err error)
-	DeleteAPIKey(ctx context.Context, id string) error
-	AddAPIKey(ctx context.Context, id string, key string) (*model.APIKey, error)
+	DeleteUser(ctx context.Context, id string) (*model.DeletionReport, error)
+	SoftDeleteUser(ctx context.Context, id string) (deletedAt time.Time, err error)
+	RestoreUser(ctx context.Context, id string, deletedAfter time.Time) (*model.User, error)
+	PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time) (int, error)
+	CreateUser(ctx context.Context, oAuth *model.OAuth, input *model.NewUser, prefilledFields []model.ProfileField) (*model.User, error)
+	GetDuplicateUsers(ctx context.Context, limit int) ([]*model.DuplicateUsers, error)
+	MergeUsers(ctx context.Context, keepId string, mergeId string) (*model.User, error)
+	SetUserRole(ctx context.Context, id string, role models.Role, changedBy string) (*model.User, error)
+	GetRoleChanges(ctx context.Context, userId string) ([]*model.RoleChange, error)
+
+	CreateSession(ctx context.Context, userId string, userAgent *string) (refreshToken string, err error)
+	RotateRefreshToken(ctx context.Context, refreshToken string) (userId string, newRefreshToken string, err error)
+	RevokeSession(ctx context.Context, refreshToken string) error
+	RevokeAllSessions(ctx context.Context, userId string) error
+	GetSessions(ctx context.Context, userId string) ([]*model.Session, error)
+	GetAPIKeys(ctx context.Context, userId string) ([]*model.APIKey, error)
+	DeleteAPIKey(ctx context.Context, userId string, id string) error
+	AddAPIKey(ctx context.Context, userId string, input *model.NewAPIKey) (*model.NewAPIKeyPayload, error)
+	GetUserByAPIKey(ctx context.Context, key string) (*model.User, *model.APIKey, error)
 
 	GetUserEducationInfo(ctx context.Context, userId string) (*model.EducationInfo, error)
+
+	GetPronounOptions(ctx context.Context, includeRetired bool) ([]*model.PronounOption, error)
+	AddPronounOption(ctx context.Context, input *model.PronounsInput) (*model.PronounOption, error)
+	RetirePronounOption(ctx context.Context, id string) (*model.PronounOption, error)
+	MergePronouns(ctx context.Context, keepId string, mergeId string) (*model.PronounOption, error)
+
+	GetUserData(ctx context.Context, userId string) (*model.UserData, error)
+	GetUserVersions(ctx context.Context, userId string) ([]*model.UserVersion, error)
+	GetUserVersionAsOf(ctx context.Context, userId string, asOf time.Time) (*model.UserVersion, error)
+	GetAuditLog(ctx context.Context, userId string, first int, after *cursor.Cursor) (*model.AuditLogConnection, error)
 }
@@ -0,0 +1,315 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"github.com/KnightHacks/knighthacks_users/phonenumber"
+	"net/mail"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	// phoneNumberPattern allows the usual formatting characters around the digits
+	phoneNumberPattern  = regexp.MustCompile(`^\+?[0-9 ().-]+$`)
+	usPostalCodePattern = regexp.MustCompile(`^[0-9]{5}(-[0-9]{4})?$`)
+	postalCodePattern   = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9 -]{1,9}$`)
+)
+
+// usCountryNames are the values of MailingAddress.country that get US postal code validation
+var usCountryNames = map[string]bool{
+	"united states":            true,
+	"united states of america": true,
+	"us":                       true,
+	"usa":                      true,
+}
+
+// Rules are the limits user input is validated against, they can be changed per event
+type Rules struct {
+	MinAge               int
+	MaxAge               int
+	MaxYearsOfExperience float64
+	MaxNameLength        int
+	// MaxYearsUntilGraduation is how far in the future a graduation date can be
+	MaxYearsUntilGraduation int
+}
+
+// DefaultRules are used for everything that isn't set in the environment, the minimum age
+// is the youngest MLH allows at their events
+func DefaultRules() Rules {
+	return Rules{
+		MinAge:                  13,
+		MaxAge:                  120,
+		MaxYearsOfExperience:    50,
+		MaxNameLength:           64,
+		MaxYearsUntilGraduation: 10,
+	}
+}
+
+// RulesFromEnvironment returns the default rules overridden by the MIN_AGE, MAX_AGE,
+// MAX_YEARS_OF_EXPERIENCE, MAX_NAME_LENGTH and MAX_YEARS_UNTIL_GRADUATION environment variables
+func RulesFromEnvironment() (Rules, error) {
+	rules := DefaultRules()
+	for name, value := range map[string]*int{
+		"MIN_AGE":                    &rules.MinAge,
+		"MAX_AGE":                    &rules.MaxAge,
+		"MAX_NAME_LENGTH":            &rules.MaxNameLength,
+		"MAX_YEARS_UNTIL_GRADUATION": &rules.MaxYearsUntilGraduation,
+	} {
+		if env, ok := os.LookupEnv(name); ok {
+			parsed, err := strconv.Atoi(env)
+			if err != nil {
+				return Rules{}, fmt.Errorf("%s is not a valid integer: %w", name, err)
+			}
+			*value = parsed
+		}
+	}
+	if env, ok := os.LookupEnv("MAX_YEARS_OF_EXPERIENCE"); ok {
+		parsed, err := strconv.ParseFloat(env, 64)
+		if err != nil {
+			return Rules{}, fmt.Errorf("MAX_YEARS_OF_EXPERIENCE is not a valid number: %w", err)
+		}
+		rules.MaxYearsOfExperience = parsed
+	}
+	if rules.MinAge > rules.MaxAge {
+		return Rules{}, fmt.Errorf("MIN_AGE %d is greater than MAX_AGE %d", rules.MinAge, rules.MaxAge)
+	}
+	return rules, nil
+}
+
+// FieldError is a problem with a single field of the input, the field is the path of the
+// field in the input such as mailingAddress.postalCode
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Errors are every problem found with the input, it is only returned when there is at least one
+type Errors []*FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, fieldError := range e {
+		messages = append(messages, fieldError.Field+": "+fieldError.Message)
+	}
+	return "invalid input, " + strings.Join(messages, ", ")
+}
+
+// validator collects the errors of every field so they can all be returned at once
+type validator struct {
+	rules  Rules
+	now    time.Time
+	errors Errors
+}
+
+func (v *validator) fail(field string, format string, args ...interface{}) {
+	v.errors = append(v.errors, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) err() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return v.errors
+}
+
+// ValidateNewUser returns Errors if any field of the new user is not valid
+func (r Rules) ValidateNewUser(input *model.NewUser, now time.Time) error {
+	v := &validator{rules: r, now: now}
+	v.name("firstName", input.FirstName)
+	v.name("lastName", input.LastName)
+	v.email("email", input.Email)
+	region := phonenumber.DefaultRegion
+	if input.MailingAddress != nil {
+		region = phonenumber.RegionForCountry(input.MailingAddress.Country)
+	}
+	v.phoneNumber("phoneNumber", input.PhoneNumber, region)
+	v.age("age", input.Age)
+	v.yearsOfExperience("yearsOfExperience", input.YearsOfExperience)
+	if input.MailingAddress != nil {
+		v.required("mailingAddress.country", input.MailingAddress.Country)
+		v.required("mailingAddress.state", input.MailingAddress.State)
+		v.required("mailingAddress.city", input.MailingAddress.City)
+		v.postalCode("mailingAddress.postalCode", input.MailingAddress.Country, input.MailingAddress.PostalCode)
+		v.addressLines("mailingAddress.addressLines", input.MailingAddress.AddressLines)
+	}
+	if input.EducationInfo != nil {
+		v.required("educationInfo.name", input.EducationInfo.Name)
+		v.required("educationInfo.major", input.EducationInfo.Major)
+		v.graduationDate("educationInfo.graduationDate", input.EducationInfo.GraduationDate)
+	}
+	return v.err()
+}
+
+// ValidateUpdatedUser returns Errors if any field being updated is not valid, the fields
+// that are not being updated are not checked
+func (r Rules) ValidateUpdatedUser(input *model.UpdatedUser, now time.Time) error {
+	v := &validator{rules: r, now: now}
+	if input.FirstName != nil {
+		v.name("firstName", *input.FirstName)
+	}
+	if input.LastName != nil {
+		v.name("lastName", *input.LastName)
+	}
+	if input.Email != nil {
+		v.email("email", *input.Email)
+	}
+	if input.PhoneNumber != nil {
+		// the region is only known if the mailing address is updated too, otherwise the
+		// number is checked against the stored mailing address when it is saved
+		region := ""
+		if input.MailingAddress != nil && input.MailingAddress.Country != nil {
+			region = phonenumber.RegionForCountry(*input.MailingAddress.Country)
+		}
+		v.phoneNumber("phoneNumber", *input.PhoneNumber, region)
+	}
+	v.age("age", input.Age)
+	v.yearsOfExperience("yearsOfExperience", input.YearsOfExperience)
+	if input.MailingAddress != nil {
+		if input.MailingAddress.Country != nil {
+			v.required("mailingAddress.country", *input.MailingAddress.Country)
+		}
+		if input.MailingAddress.State != nil {
+			v.required("mailingAddress.state", *input.MailingAddress.State)
+		}
+		if input.MailingAddress.City != nil {
+			v.required("mailingAddress.city", *input.MailingAddress.City)
+		}
+		if input.MailingAddress.PostalCode != nil {
+			// the country isn't known unless it is updated as well, so only the general pattern is checked
+			country := ""
+			if input.MailingAddress.Country != nil {
+				country = *input.MailingAddress.Country
+			}
+			v.postalCode("mailingAddress.postalCode", country, *input.MailingAddress.PostalCode)
+		}
+		if input.MailingAddress.AddressLines != nil {
+			v.addressLines("mailingAddress.addressLines", input.MailingAddress.AddressLines)
+		}
+	}
+	if input.EducationInfo != nil {
+		if input.EducationInfo.Name != nil {
+			v.required("educationInfo.name", *input.EducationInfo.Name)
+		}
+		if input.EducationInfo.Major != nil {
+			v.required("educationInfo.major", *input.EducationInfo.Major)
+		}
+		if input.EducationInfo.GraduationDate != nil {
+			v.graduationDate("educationInfo.graduationDate", *input.EducationInfo.GraduationDate)
+		}
+	}
+	return v.err()
+}
+
+func (v *validator) required(field string, value string) bool {
+	if strings.TrimSpace(value) == "" {
+		v.fail(field, "must not be empty")
+		return false
+	}
+	return true
+}
+
+func (v *validator) name(field string, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	if len([]rune(value)) > v.rules.MaxNameLength {
+		v.fail(field, "must be at most %d characters", v.rules.MaxNameLength)
+		return
+	}
+	for _, r := range value {
+		if unicode.IsControl(r) || unicode.IsDigit(r) {
+			v.fail(field, "must not contain digits or control characters")
+			return
+		}
+	}
+}
+
+func (v *validator) email(field string, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	// ParseAddress also accepts a display name, the address on its own must be the whole value
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Address != value || !strings.Contains(value[strings.LastIndex(value, "@"):], ".") {
+		v.fail(field, "must be a valid email address")
+	}
+}
+
+func (v *validator) phoneNumber(field string, value string, region string) {
+	if !v.required(field, value) {
+		return
+	}
+	if region != "" {
+		if _, err := phonenumber.Normalize(value, region); err != nil {
+			v.fail(field, "must be a valid phone number")
+		}
+		return
+	}
+	digits := 0
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+	if !phoneNumberPattern.MatchString(value) || digits < 10 || digits > 15 {
+		v.fail(field, "must be a valid phone number")
+	}
+}
+
+func (v *validator) postalCode(field string, country string, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	pattern := postalCodePattern
+	if usCountryNames[strings.ToLower(strings.TrimSpace(country))] {
+		pattern = usPostalCodePattern
+	}
+	if !pattern.MatchString(strings.TrimSpace(value)) {
+		v.fail(field, "must be a valid postal code")
+	}
+}
+
+func (v *validator) addressLines(field string, lines []string) {
+	if len(lines) == 0 {
+		v.fail(field, "must have at least one line")
+		return
+	}
+	for i, line := range lines {
+		v.required(fmt.Sprintf("%s.%d", field, i), line)
+	}
+}
+
+func (v *validator) age(field string, age *int) {
+	if age == nil {
+		return
+	}
+	if *age < v.rules.MinAge || *age > v.rules.MaxAge {
+		v.fail(field, "must be between %d and %d", v.rules.MinAge, v.rules.MaxAge)
+	}
+}
+
+func (v *validator) yearsOfExperience(field string, years *float64) {
+	if years == nil {
+		return
+	}
+	if *years < 0 || *years > v.rules.MaxYearsOfExperience {
+		v.fail(field, "must be between 0 and %v", v.rules.MaxYearsOfExperience)
+	}
+}
+
+func (v *validator) graduationDate(field string, date time.Time) {
+	// anyone graduating today is still allowed
+	today := time.Date(v.now.Year(), v.now.Month(), v.now.Day(), 0, 0, 0, 0, v.now.Location())
+	if date.Before(today) {
+		v.fail(field, "must not be in the past")
+		return
+	}
+	if date.After(v.now.AddDate(v.rules.MaxYearsUntilGraduation, 0, 0)) {
+		v.fail(field, "must be within %d years", v.rules.MaxYearsUntilGraduation)
+	}
+}
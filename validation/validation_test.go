@@ -0,0 +1,134 @@
+package validation
+
+import (
+	"errors"
+	"github.com/KnightHacks/knighthacks_shared/utils"
+	"github.com/KnightHacks/knighthacks_users/graph/model"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var now = time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func validNewUser() *model.NewUser {
+	return &model.NewUser{
+		FirstName:   "Joe",
+		LastName:    "Bob",
+		Email:       "joe.bob@example.com",
+		PhoneNumber: "+1 (407) 200-3000",
+		Age:         utils.Ptr(22),
+		MailingAddress: &model.MailingAddressInput{
+			Country:      "United States",
+			State:        "Florida",
+			City:         "Orlando",
+			PostalCode:   "32816",
+			AddressLines: []string{"4000 Central Florida Blvd"},
+		},
+		YearsOfExperience: utils.Ptr(3.5),
+		EducationInfo: &model.EducationInfoInput{
+			Name:           "University of Central Florida",
+			GraduationDate: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+			Major:          "Computer Science",
+		},
+	}
+}
+
+func TestRules_ValidateNewUser(t *testing.T) {
+	tests := []struct {
+		name       string
+		rules      Rules
+		change     func(user *model.NewUser)
+		wantFields []string
+	}{
+		{
+			name:   "valid user",
+			rules:  DefaultRules(),
+			change: func(user *model.NewUser) {},
+		},
+		{
+			name:  "every invalid field is returned",
+			rules: DefaultRules(),
+			change: func(user *model.NewUser) {
+				user.FirstName = " "
+				user.Email = "Joe <joe.bob@example.com>"
+				user.PhoneNumber = "call me"
+				user.Age = utils.Ptr(-1)
+				user.YearsOfExperience = utils.Ptr(-2.0)
+				user.MailingAddress.PostalCode = "ABC"
+				user.EducationInfo.GraduationDate = time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+			},
+			wantFields: []string{
+				"firstName",
+				"email",
+				"phoneNumber",
+				"age",
+				"yearsOfExperience",
+				"mailingAddress.postalCode",
+				"educationInfo.graduationDate",
+			},
+		},
+		{
+			name:  "non US postal codes use the general pattern",
+			rules: DefaultRules(),
+			change: func(user *model.NewUser) {
+				user.MailingAddress.Country = "Canada"
+				user.MailingAddress.PostalCode = "K1A 0B1"
+			},
+		},
+		{
+			name:  "graduating today is allowed",
+			rules: DefaultRules(),
+			change: func(user *model.NewUser) {
+				user.EducationInfo.GraduationDate = time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+			},
+		},
+		{
+			name:  "minimum age is configurable",
+			rules: Rules{MinAge: 18, MaxAge: 120, MaxYearsOfExperience: 50, MaxNameLength: 64, MaxYearsUntilGraduation: 10},
+			change: func(user *model.NewUser) {
+				user.Age = utils.Ptr(17)
+			},
+			wantFields: []string{"age"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := validNewUser()
+			tt.change(user)
+			err := tt.rules.ValidateNewUser(user, now)
+			if gotFields := fields(t, err); !reflect.DeepEqual(gotFields, tt.wantFields) {
+				t.Errorf("ValidateNewUser() fields = %v, want %v", gotFields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestRules_ValidateUpdatedUser(t *testing.T) {
+	err := DefaultRules().ValidateUpdatedUser(&model.UpdatedUser{
+		LastName: utils.Ptr("Bob"),
+		Age:      utils.Ptr(500),
+		MailingAddress: &model.MailingAddressUpdate{
+			AddressLines: []string{},
+		},
+	}, now)
+	wantFields := []string{"age", "mailingAddress.addressLines"}
+	if gotFields := fields(t, err); !reflect.DeepEqual(gotFields, wantFields) {
+		t.Errorf("ValidateUpdatedUser() fields = %v, want %v", gotFields, wantFields)
+	}
+}
+
+func fields(t *testing.T, err error) []string {
+	if err == nil {
+		return nil
+	}
+	var fieldErrors Errors
+	if !errors.As(err, &fieldErrors) {
+		t.Fatalf("error = %v, want Errors", err)
+	}
+	names := make([]string, 0, len(fieldErrors))
+	for _, fieldError := range fieldErrors {
+		names = append(names, fieldError.Field)
+	}
+	return names
+}